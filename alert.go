@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Alert function renders a notice block, like the admonitions of Markdown.
+// It takes a status (see Status), a title, a body and a list of style options as input.
+// The block has a left border in the color of the status, the glyph of the
+// status and the bold title on the first line, and the body below it. The
+// body is wrapped to the width set by the style options or, if no width is
+// set, to the width of the terminal.
+// The style options are applied after the default style, so they can override it.
+// Example:
+//
+//	Alert(StatusWarning, "Deprecated", "the --legacy flag will be removed in v2")
+func Alert(status Status, title, body string, options ...StyleOption) string {
+	style := NewStyle(append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		return s.Border(AdaptBorder(lipgloss.ThickBorder()), false, false, false, true).
+			BorderForeground(status.Color()).
+			PaddingLeft(Spacing(1))
+	}}, options...)...)
+
+	width := style.GetWidth()
+	if width <= 0 {
+		width, _ = getTerminalSize()
+		width -= style.GetHorizontalBorderSize()
+	}
+	width = max(width-style.GetHorizontalPadding(), 1)
+
+	lines := make([]string, 0, 2)
+	if title != "" {
+		lines = append(lines, lipgloss.NewStyle().Foreground(status.Color()).Bold(true).Render(WrapString(status.Glyph()+" "+title, width)))
+	}
+	if body = strings.TrimSpace(body); body != "" {
+		lines = append(lines, lipgloss.NewStyle().Foreground(CurrentPalette().Bright).Render(WrapString(body, width)))
+	}
+
+	return style.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,28 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestAlert(t *testing.T) {
+	defer SetTerminalSize(0, 0)
+	SetTerminalSize(14, 10)
+
+	tests := []struct {
+		status   Status
+		title    string
+		body     string
+		expected string
+	}{
+		{StatusInfo, "Note", "", "┃ ℹ Note"},
+		{StatusWarning, "Deprecated", "use the new flag", "┃ ⚠ Deprecated\n┃ use the new \n┃ flag        "},
+		{StatusError, "", "failed", "┃ failed"},
+	}
+
+	for _, test := range tests {
+		result := Alert(test.status, test.title, test.body)
+		if result != test.expected {
+			t.Errorf("Alert(%d, %q, %q) = %q; expected %q", test.status, test.title, test.body, result, test.expected)
+		}
+	}
+}
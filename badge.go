@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// BadgeKind type represents the kind of a badge, which determines its color.
+type BadgeKind int
+
+// badge kinds
+const (
+	BadgeAccent BadgeKind = iota
+	BadgeSuccess
+	BadgeWarning
+	BadgeError
+	BadgeInfo
+	BadgeMuted
+)
+
+// Color method returns the palette color associated with the badge kind.
+func (k BadgeKind) Color() lipgloss.AdaptiveColor {
+	switch k {
+	case BadgeSuccess:
+		return CurrentPalette().Success
+	case BadgeWarning:
+		return CurrentPalette().Warning
+	case BadgeError:
+		return CurrentPalette().Error
+	case BadgeInfo:
+		return CurrentPalette().Info
+	case BadgeMuted:
+		return CurrentPalette().Muted
+	default:
+		return CurrentPalette().Accent
+	}
+}
+
+// Badge function renders a small label (e.g. "beta", "failed") as a pill
+// with the color of its kind as background.
+// It takes a text, the kind of the badge and a list of style options as input.
+// Without color support (see CurrentProfile) the badge is rendered in square
+// brackets, so that it is still distinguishable from the surrounding text.
+// The style options are applied after the default style, so they can override it.
+func Badge(text string, kind BadgeKind, options ...StyleOption) string {
+	padding := Spacing(1)
+	if CurrentProfile().Colors == NoColor {
+		text, padding = "["+text+"]", 0
+	}
+
+	return Render(text, append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		// the light variants of the palette are dark and the dark variants
+		// are light, so the text uses the opposite of the background
+		return s.Background(kind.Color()).
+			Foreground(lipgloss.AdaptiveColor{Light: "15", Dark: "0"}).
+			Bold(true).
+			Padding(0, padding)
+	}}, options...)...)
+}
+
+// TagList function lays out a list of badges (see Badge) from left to right,
+// wrapping them on several lines if they do not fit the width.
+// It takes the maximum width and the rendered badges as input; the badges are
+// separated by a gap of 1 (adjusted to the density of the theme, see Spacing).
+// If the width is less than or equal to 0, the width of the terminal is used.
+func TagList(width int, badges ...string) string {
+	if width <= 0 {
+		width, _ = getTerminalSize()
+	}
+
+	return flow(width, Spacing(1), badges...)
+}
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestBadge(t *testing.T) {
+	tests := []struct {
+		text     string
+		kind     BadgeKind
+		expected string
+	}{
+		{"beta", BadgeAccent, "[beta]"},
+		{"failed", BadgeError, "[failed]"},
+	}
+
+	for _, test := range tests {
+		result := Badge(test.text, test.kind)
+		if result != test.expected {
+			t.Errorf("Badge(%q, %d) = %q; expected %q", test.text, test.kind, result, test.expected)
+		}
+	}
+}
+
+func TestTagList(t *testing.T) {
+	tests := []struct {
+		width    int
+		badges   []string
+		expected string
+	}{
+		{20, []string{}, ""},
+		{20, []string{"[go]", "[cli]", "[tui]"}, "[go] [cli] [tui]"},
+		{10, []string{"[go]", "[cli]", "[tui]"}, "[go] [cli]\n[tui]"},
+	}
+
+	for _, test := range tests {
+		result := TagList(test.width, test.badges...)
+		if result != test.expected {
+			t.Errorf("TagList(%d, %q) = %q; expected %q", test.width, test.badges, result, test.expected)
+		}
+	}
+}
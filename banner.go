@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// BannerBlock is the symbol used to draw the pixels of the banner letters.
+var BannerBlock = "█"
+
+// bannerFont is the embedded bitmap font used by Banner: each glyph is 5 rows
+// high, with "#" for the pixels that are drawn.
+var bannerFont = map[rune][5]string{
+	'A': {" ### ", "#   #", "#####", "#   #", "#   #"},
+	'B': {"#### ", "#   #", "#### ", "#   #", "#### "},
+	'C': {" ####", "#    ", "#    ", "#    ", " ####"},
+	'D': {"#### ", "#   #", "#   #", "#   #", "#### "},
+	'E': {"#####", "#    ", "#### ", "#    ", "#####"},
+	'F': {"#####", "#    ", "#### ", "#    ", "#    "},
+	'G': {" ####", "#    ", "#  ##", "#   #", " ####"},
+	'H': {"#   #", "#   #", "#####", "#   #", "#   #"},
+	'I': {"###", " # ", " # ", " # ", "###"},
+	'J': {"  ###", "    #", "    #", "#   #", " ### "},
+	'K': {"#   #", "#  # ", "###  ", "#  # ", "#   #"},
+	'L': {"#    ", "#    ", "#    ", "#    ", "#####"},
+	'M': {"#   #", "## ##", "# # #", "#   #", "#   #"},
+	'N': {"#   #", "##  #", "# # #", "#  ##", "#   #"},
+	'O': {" ### ", "#   #", "#   #", "#   #", " ### "},
+	'P': {"#### ", "#   #", "#### ", "#    ", "#    "},
+	'Q': {" ### ", "#   #", "# # #", "#  # ", " ## #"},
+	'R': {"#### ", "#   #", "#### ", "#  # ", "#   #"},
+	'S': {" ####", "#    ", " ### ", "    #", "#### "},
+	'T': {"#####", "  #  ", "  #  ", "  #  ", "  #  "},
+	'U': {"#   #", "#   #", "#   #", "#   #", " ### "},
+	'V': {"#   #", "#   #", "#   #", " # # ", "  #  "},
+	'W': {"#   #", "#   #", "# # #", "## ##", "#   #"},
+	'X': {"#   #", " # # ", "  #  ", " # # ", "#   #"},
+	'Y': {"#   #", " # # ", "  #  ", "  #  ", "  #  "},
+	'Z': {"#####", "   # ", "  #  ", " #   ", "#####"},
+	'0': {" ### ", "#  ##", "# # #", "##  #", " ### "},
+	'1': {" # ", "## ", " # ", " # ", "###"},
+	'2': {" ### ", "#   #", "  ## ", " #   ", "#####"},
+	'3': {"#### ", "    #", " ### ", "    #", "#### "},
+	'4': {"#   #", "#   #", "#####", "    #", "    #"},
+	'5': {"#####", "#    ", "#### ", "    #", "#### "},
+	'6': {" ### ", "#    ", "#### ", "#   #", " ### "},
+	'7': {"#####", "    #", "   # ", "  #  ", "  #  "},
+	'8': {" ### ", "#   #", " ### ", "#   #", " ### "},
+	'9': {" ### ", "#   #", " ####", "    #", " ### "},
+	' ': {"   ", "   ", "   ", "   ", "   "},
+	'!': {"#", "#", "#", " ", "#"},
+	'?': {" ### ", "#   #", "  ## ", "     ", "  #  "},
+	'.': {" ", " ", " ", " ", "#"},
+	',': {"  ", "  ", "  ", " #", "# "},
+	':': {" ", "#", " ", "#", " "},
+	'-': {"    ", "    ", "####", "    ", "    "},
+	'+': {"     ", "  #  ", "#####", "  #  ", "     "},
+	'/': {"    #", "   # ", "  #  ", " #   ", "#    "},
+}
+
+// Banner function renders a text in large block letters, e.g. for the splash
+// header of a command line tool.
+// It takes a text and a list of style options as input.
+// The letters are drawn with an embedded 5 rows high bitmap font, which
+// covers the latin letters (lowercase letters are rendered as uppercase),
+// the digits and some punctuation; the other characters are rendered as "?".
+// Each line of the text is rendered as a separate banner.
+// By default the banner is rendered with the accent color; the style options
+// are applied afterwards, so they can override it.
+func Banner(text string, options ...StyleOption) string {
+	block := AdaptGlyph(BannerBlock)
+	banners := make([]string, 0, 1)
+	for _, line := range strings.Split(text, "\n") {
+		var rows [5][]string
+		for _, r := range line {
+			glyph, ok := bannerFont[unicode.ToUpper(r)]
+			if !ok {
+				glyph = bannerFont['?']
+			}
+			for i := range rows {
+				rows[i] = append(rows[i], strings.ReplaceAll(glyph[i], "#", block))
+			}
+		}
+
+		lines := make([]string, len(rows))
+		for i, row := range rows {
+			lines[i] = strings.Join(row, " ")
+		}
+		banners = append(banners, strings.Join(lines, "\n"))
+	}
+
+	return Render(strings.Join(banners, "\n\n"), append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		return s.Foreground(CurrentPalette().Accent)
+	}}, options...)...)
+}
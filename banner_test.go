@@ -0,0 +1,28 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestBanner(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{
+			text:     "Hi",
+			expected: "█   █ ███\n█   █  █ \n█████  █ \n█   █  █ \n█   █ ███",
+		},
+		{
+			text:     "1\n~",
+			expected: " █   \n██   \n █   \n █   \n███  \n     \n ███ \n█   █\n  ██ \n     \n  █  ",
+		},
+	}
+
+	for _, test := range tests {
+		result := Banner(test.text)
+		if result != test.expected {
+			t.Errorf("Banner(%q) = %q; expected %q", test.text, result, test.expected)
+		}
+	}
+}
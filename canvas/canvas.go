@@ -0,0 +1,281 @@
+package canvas
+
+import (
+	"strings"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// braille dots, by column and row of the dot inside the cell
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// cell type is a cell of the canvas: a symbol (or a set of braille dots) and its style.
+// A wide symbol (e.g. a CJK rune) takes two cells: the second one is marked
+// as covered and is not rendered.
+type cell struct {
+	symbol  rune
+	dots    rune
+	style   *lipgloss.Style
+	covered bool
+}
+
+// Canvas type represents a grid of cells that can be drawn on one by one,
+// e.g. to render charts, diagrams or custom visualizations.
+// Every drawing method ignores the cells out of the canvas.
+// A Canvas is rendered with the String method, so it can be composed with
+// the other elements of the package.
+type Canvas struct {
+	width  int
+	height int
+	cells  [][]cell
+}
+
+// New function returns a new empty canvas with the given size.
+// A width or a height less than 0 is set to 0.
+func New(width, height int) *Canvas {
+	width, height = max(width, 0), max(height, 0)
+	cells := make([][]cell, height)
+	for y := range cells {
+		cells[y] = make([]cell, width)
+	}
+
+	return &Canvas{width: width, height: height, cells: cells}
+}
+
+// Width method returns the width of the canvas.
+func (c *Canvas) Width() int {
+	return c.width
+}
+
+// Height method returns the height of the canvas.
+func (c *Canvas) Height() int {
+	return c.height
+}
+
+// SetCell method sets the symbol and the style of a cell.
+// It takes the column and the row of the cell (starting from 0), a rune and a style as input.
+// A wide rune (e.g. a CJK rune or an emoji) takes the cell and the next one;
+// if the next cell is out of the canvas, the rune is not drawn.
+// A wide rune partially overwritten by another rune is removed.
+func (c *Canvas) SetCell(x, y int, r rune, style lipgloss.Style) *Canvas {
+	if !c.contains(x, y) {
+		return c
+	}
+
+	wide := runeWidth(r) > 1
+	if wide && !c.contains(x+1, y) {
+		return c
+	}
+
+	c.uncover(x, y)
+	c.cells[y][x] = cell{symbol: r, style: &style}
+	if wide {
+		c.uncover(x+1, y)
+		c.cells[y][x+1] = cell{covered: true}
+	}
+	return c
+}
+
+// Clear method removes all the cells of the canvas.
+func (c *Canvas) Clear() *Canvas {
+	for y := range c.cells {
+		c.cells[y] = make([]cell, c.width)
+	}
+	return c
+}
+
+// DrawText method writes a single-line text starting from a cell, one rune
+// per cell. Wide runes take two cells (see SetCell), so the text takes as
+// many cells as its width.
+func (c *Canvas) DrawText(x, y int, text string, style lipgloss.Style) *Canvas {
+	for _, r := range text {
+		c.SetCell(x, y, r, style)
+		x += max(runeWidth(r), 1)
+	}
+	return c
+}
+
+// DrawLine method draws a line of runes between two cells (both included).
+// Lines that are not horizontal, vertical or diagonal are approximated with
+// the Bresenham algorithm.
+func (c *Canvas) DrawLine(x0, y0, x1, y1 int, r rune, style lipgloss.Style) *Canvas {
+	line(x0, y0, x1, y1, func(x, y int) {
+		c.SetCell(x, y, r, style)
+	})
+	return c
+}
+
+// DrawRect method draws the border of a rectangle.
+// It takes the column and the row of the top-left cell, the width and the
+// height of the rectangle, a lipgloss border and a style as input.
+// The border is replaced with its ASCII variant on terminals without Unicode
+// support (see tui.AdaptBorder). Rectangles smaller than 2x2 are not drawn.
+func (c *Canvas) DrawRect(x, y, width, height int, border lipgloss.Border, style lipgloss.Style) *Canvas {
+	if width < 2 || height < 2 {
+		return c
+	}
+
+	border = tui.AdaptBorder(border)
+	right, bottom := x+width-1, y+height-1
+	for i := x + 1; i < right; i++ {
+		c.SetCell(i, y, first(border.Top), style)
+		c.SetCell(i, bottom, first(border.Bottom), style)
+	}
+	for i := y + 1; i < bottom; i++ {
+		c.SetCell(x, i, first(border.Left), style)
+		c.SetCell(right, i, first(border.Right), style)
+	}
+	c.SetCell(x, y, first(border.TopLeft), style)
+	c.SetCell(right, y, first(border.TopRight), style)
+	c.SetCell(x, bottom, first(border.BottomLeft), style)
+	c.SetCell(right, bottom, first(border.BottomRight), style)
+	return c
+}
+
+// Plot method sets a braille dot. Each cell holds 2x4 dots, so the dots have
+// a resolution twice the width and four times the height of the canvas.
+// It takes the column and the row of the dot (starting from 0) and a style as input.
+// The dots of the same cell are merged; the style of the cell is the style
+// of the last dot set.
+func (c *Canvas) Plot(x, y int, style lipgloss.Style) *Canvas {
+	if x < 0 || y < 0 || !c.contains(x/2, y/4) {
+		return c
+	}
+
+	c.uncover(x/2, y/4)
+	cl := &c.cells[y/4][x/2]
+	if cl.dots == 0 {
+		cl.symbol = 0
+	}
+	cl.dots |= brailleDots[x%2][y%4]
+	cl.style = &style
+	return c
+}
+
+// PlotLine method draws a line of braille dots between two dots (both included, see Plot).
+func (c *Canvas) PlotLine(x0, y0, x1, y1 int, style lipgloss.Style) *Canvas {
+	line(x0, y0, x1, y1, func(x, y int) {
+		c.Plot(x, y, style)
+	})
+	return c
+}
+
+// String method renders the canvas.
+// The empty cells are rendered as spaces, except at the end of the rows,
+// which have no trailing empty cells.
+func (c *Canvas) String() string {
+	lines := make([]string, len(c.cells))
+	for y, row := range c.cells {
+		end := len(row)
+		for end > 0 && row[end-1].empty() {
+			end--
+		}
+
+		var b strings.Builder
+		for _, cl := range row[:end] {
+			switch {
+			case cl.covered:
+				continue
+			case cl.empty():
+				b.WriteString(" ")
+			case cl.style == nil:
+				b.WriteRune(cl.rune())
+			default:
+				b.WriteString(cl.style.Render(string(cl.rune())))
+			}
+		}
+		lines[y] = b.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// uncover method prepares a cell to be overwritten: if the cell is part of
+// a wide rune (either the rune itself or the cell it covers), the rune is
+// removed, so that no half of it is left on the canvas.
+func (c *Canvas) uncover(x, y int) {
+	row := c.cells[y]
+	if row[x].covered {
+		row[x] = cell{}
+		if x > 0 {
+			row[x-1] = cell{}
+		}
+	}
+	if x+1 < len(row) && row[x+1].covered {
+		row[x+1] = cell{}
+	}
+}
+
+// contains method reports whether a cell is inside the canvas.
+func (c *Canvas) contains(x, y int) bool {
+	return x >= 0 && y >= 0 && x < c.width && y < c.height
+}
+
+// empty method reports whether nothing is drawn in the cell.
+func (cl cell) empty() bool {
+	return cl.symbol == 0 && cl.dots == 0
+}
+
+// rune method returns the rune rendered in the cell.
+func (cl cell) rune() rune {
+	if cl.dots != 0 {
+		return 0x2800 + cl.dots
+	}
+	return cl.symbol
+}
+
+// runeWidth function returns the number of cells taken by a rune in the terminal.
+func runeWidth(r rune) int {
+	return ansi.StringWidth(string(r))
+}
+
+// first function returns the first rune of a string, or a space if the string is empty.
+func first(s string) rune {
+	for _, r := range s {
+		return r
+	}
+	return ' '
+}
+
+// line function calls the function for every point of the line between two
+// points (both included), using the Bresenham algorithm.
+func line(x0, y0, x1, y1 int, f func(x, y int)) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		f(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e := 2 * err
+		if e >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// abs function returns the absolute value of an integer.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
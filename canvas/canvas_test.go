@@ -0,0 +1,68 @@
+package canvas
+
+import (
+	"testing"
+
+	"github.com/Tagliapietra96/tui/tuitest"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestMain(m *testing.M) {
+	tuitest.Main(m)
+}
+
+func TestCanvasString(t *testing.T) {
+	style := lipgloss.NewStyle()
+	tests := []struct {
+		canvas   *Canvas
+		expected string
+	}{
+		{
+			canvas:   New(3, 2),
+			expected: "\n",
+		},
+		{
+			canvas:   New(4, 3).DrawRect(0, 0, 4, 3, lipgloss.NormalBorder(), style).SetCell(1, 1, 'x', style).SetCell(9, 9, 'y', style),
+			expected: "┌──┐\n│x │\n└──┘",
+		},
+		{
+			canvas:   New(4, 4).DrawLine(0, 0, 3, 3, '\\', style),
+			expected: "\\\n \\\n  \\\n   \\",
+		},
+		{
+			canvas:   New(5, 1).DrawText(1, 0, "hi", style),
+			expected: " hi",
+		},
+		{
+			canvas:   New(2, 1).Plot(0, 0, style).Plot(1, 3, style).PlotLine(2, 0, 3, 0, style),
+			expected: "⢁⠉",
+		},
+		{
+			canvas:   New(6, 1).DrawText(0, 0, "日本!", style),
+			expected: "日本!",
+		},
+		{
+			canvas:   New(6, 2).DrawText(0, 0, "a日b", style).DrawText(0, 1, "abcd", style),
+			expected: "a日b\nabcd",
+		},
+		{
+			canvas:   New(3, 1).DrawText(0, 0, "ab日", style),
+			expected: "ab",
+		},
+		{
+			canvas:   New(4, 1).DrawText(0, 0, "日本", style).SetCell(1, 0, 'x', style),
+			expected: " x本",
+		},
+		{
+			canvas:   New(4, 1).DrawText(0, 0, "日本", style).SetCell(2, 0, 'x', style),
+			expected: "日x",
+		},
+	}
+
+	for _, test := range tests {
+		result := test.canvas.String()
+		if result != test.expected {
+			t.Errorf("Canvas.String() = %q; expected %q", result, test.expected)
+		}
+	}
+}
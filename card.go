@@ -0,0 +1,186 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CardOption type is a function that configures a card (see Card).
+type CardOption func(*cardConfig)
+
+// cardConfig holds the options of a card.
+type cardConfig struct {
+	accent  bool
+	focused bool
+	width   int
+	height  int
+}
+
+// CardAccent returns a card option that renders the title on an accent colored strip.
+func CardAccent() CardOption {
+	return func(c *cardConfig) {
+		c.accent = true
+	}
+}
+
+// CardFocused returns a card option that renders the card as focused
+// (accent border and title) when the argument is true.
+func CardFocused(focused bool) CardOption {
+	return func(c *cardConfig) {
+		c.focused = focused
+	}
+}
+
+// CardSize returns a card option that sets the total width and height of the
+// card, borders included. A value less than or equal to 0 means that the size
+// depends on the content. With a fixed width the body is wrapped; with a
+// fixed height the body is truncated or padded so that the footer stays at
+// the bottom of the card. If the height is too small even for the title and
+// the footer, the card is cut from the bottom to fit it; the minimum height
+// is 3 (the borders and one line of content).
+func CardSize(width, height int) CardOption {
+	return func(c *cardConfig) {
+		c.width = max(width, 0)
+		c.height = max(height, 0)
+	}
+}
+
+// Card function renders a card: a bordered block with a title, a body and a footer.
+// It takes the title, the body, the footer and a list of card options as input.
+// The title is bold, the footer is muted and the sections are separated by an
+// empty line; empty sections are not rendered.
+// Example:
+//
+//	Card("api", "3 instances running", "updated 2m ago", CardAccent(), CardSize(30, 0))
+func Card(title, body, footer string, options ...CardOption) string {
+	config := &cardConfig{}
+	for _, option := range options {
+		option(config)
+	}
+
+	palette := CurrentPalette()
+
+	border := palette.Muted
+	if config.focused {
+		border = palette.Accent
+	}
+	padding := Spacing(1)
+	style := lipgloss.NewStyle().
+		Border(AdaptBorder(lipgloss.RoundedBorder())).
+		BorderForeground(border).
+		Padding(0, padding)
+
+	// the width of the text inside the padding
+	width := 0
+	if config.width > 0 {
+		width = max(config.width-2-2*padding, 1)
+		style = style.Width(width + 2*padding)
+		body = WrapString(body, width)
+		footer = WrapString(footer, width)
+		title = TruncateString(title, width)
+	} else {
+		width = max(lipgloss.Width(title), lipgloss.Width(body), lipgloss.Width(footer))
+	}
+
+	sections := make([]string, 0, 3)
+	if title != "" {
+		titleStyle := lipgloss.NewStyle().Foreground(palette.Bright).Bold(true)
+		if config.focused {
+			titleStyle = titleStyle.Foreground(palette.Accent)
+		}
+		if config.accent {
+			titleStyle = titleStyle.Foreground(palette.Accent).Reverse(true).Width(width)
+		}
+		sections = append(sections, titleStyle.Render(title))
+	}
+
+	// with a fixed height the body takes the lines left by the other
+	// sections and the separators, even if it is empty
+	lines := 0
+	if config.height > 0 {
+		lines = config.height - 2 - len(sections)*2
+		if footer != "" {
+			lines -= lipgloss.Height(footer) + 1
+		}
+		body = fitLines(body, max(lines, 0))
+	}
+	if body != "" || lines > 0 {
+		sections = append(sections, lipgloss.NewStyle().Foreground(palette.Bright).Render(body))
+	}
+	if footer != "" {
+		sections = append(sections, lipgloss.NewStyle().Foreground(palette.Muted).Render(footer))
+	}
+
+	content := strings.Join(sections, "\n\n")
+	if config.height > 0 {
+		// the sections that do not fit in the height are cut from the bottom
+		content = fitLines(content, max(config.height-2, 1))
+	}
+
+	return style.Render(content)
+}
+
+// fitLines function returns a string with exactly the given number of lines,
+// truncating the extra lines or adding empty ones.
+func fitLines(s string, n int) string {
+	if n == 0 {
+		return ""
+	}
+
+	lines := strings.Split(s, "\n")
+	for len(lines) < n {
+		lines = append(lines, "")
+	}
+	return strings.Join(lines[:n], "\n")
+}
+
+// CardGrid function packs a list of rendered cards (see Card) into columns,
+// masonry style: each card is placed at the bottom of the shortest column,
+// so that cards of different heights leave as few gaps as possible.
+// It takes the number of columns and the cards as input.
+// If the number of columns is less than or equal to 0, it is computed from the
+// width of the terminal and the width of the widest card, so that calling
+// CardGrid again after a resize reflows the cards.
+// The columns and the cards are separated by a gap of 1 (adjusted to the
+// density of the theme, see Spacing).
+func CardGrid(columns int, cards ...string) string {
+	if len(cards) == 0 {
+		return ""
+	}
+
+	gap := Spacing(1)
+	if columns <= 0 {
+		width, _ := getTerminalSize()
+		cardWidth := 0
+		for _, card := range cards {
+			cardWidth = max(cardWidth, lipgloss.Width(card))
+		}
+		columns = max((width+gap)/max(cardWidth+gap, 1), 1)
+	}
+	columns = min(columns, len(cards))
+
+	stacks := make([][]string, columns)
+	heights := make([]int, columns)
+	for _, card := range cards {
+		shortest := 0
+		for i, h := range heights {
+			if h < heights[shortest] {
+				shortest = i
+			}
+		}
+
+		stacks[shortest] = append(stacks[shortest], card)
+		heights[shortest] += lipgloss.Height(card) + gap
+	}
+
+	blocks := make([]string, 0, columns*2)
+	for i, stack := range stacks {
+		if i > 0 {
+			blocks = append(blocks, strings.Repeat(" ", gap))
+		}
+		blocks = append(blocks, strings.Join(stack, strings.Repeat("\n", gap+1)))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, blocks...)
+}
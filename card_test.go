@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestCard(t *testing.T) {
+	tests := []struct {
+		title    string
+		body     string
+		footer   string
+		options  []CardOption
+		expected string
+	}{
+		{
+			title:    "api",
+			body:     "running",
+			footer:   "",
+			expected: "╭─────────╮\n│ api     │\n│         │\n│ running │\n╰─────────╯",
+		},
+		{
+			title:    "",
+			body:     "one two",
+			footer:   "ok",
+			options:  []CardOption{CardSize(9, 7)},
+			expected: "╭───────╮\n│ one   │\n│ two   │\n│       │\n│       │\n│ ok    │\n╰───────╯",
+		},
+		{
+			title:    "db",
+			body:     "a\nb\nc",
+			footer:   "",
+			options:  []CardOption{CardSize(0, 5), CardFocused(true)},
+			expected: "╭────╮\n│ db │\n│    │\n│ a  │\n╰────╯",
+		},
+		{
+			title:    "api",
+			body:     "body",
+			footer:   "foot",
+			options:  []CardOption{CardSize(4, 3)},
+			expected: "╭───╮\n│ a │\n╰───╯",
+		},
+		{
+			title:    "api",
+			body:     "body",
+			footer:   "foot",
+			options:  []CardOption{CardSize(4, 1)},
+			expected: "╭───╮\n│ a │\n╰───╯",
+		},
+		{
+			title:    "api",
+			body:     "body",
+			footer:   "ok",
+			options:  []CardOption{CardSize(0, 4)},
+			expected: "╭─────╮\n│ api │\n│     │\n╰─────╯",
+		},
+	}
+
+	for _, test := range tests {
+		result := Card(test.title, test.body, test.footer, test.options...)
+		if result != test.expected {
+			t.Errorf("Card(%q, %q, %q) = %q; expected %q", test.title, test.body, test.footer, result, test.expected)
+		}
+	}
+}
+
+func TestCardGrid(t *testing.T) {
+	tests := []struct {
+		columns  int
+		cards    []string
+		expected string
+	}{
+		{
+			columns:  2,
+			cards:    []string{},
+			expected: "",
+		},
+		{
+			columns:  2,
+			cards:    []string{"a\na\na", "b", "c"},
+			expected: "a b\na  \na c",
+		},
+		{
+			columns:  3,
+			cards:    []string{"aa", "b"},
+			expected: "aa b",
+		},
+	}
+
+	for _, test := range tests {
+		result := CardGrid(test.columns, test.cards...)
+		if result != test.expected {
+			t.Errorf("CardGrid(%d, %q) = %q; expected %q", test.columns, test.cards, result, test.expected)
+		}
+	}
+
+	// empty cards without a gap must not divide by zero
+	defer SetDensity(DensityNormal)
+	SetDensity(DensityCompact)
+	if result := CardGrid(0, "", ""); result != "" {
+		t.Errorf("CardGrid(%d, %q) = %q; expected %q", 0, []string{"", ""}, result, "")
+	}
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Highlighter interface is implemented by the syntax highlighters used by CodeBlock.
+// Highlight returns the code with ANSI styles for the given language (which
+// may be empty); it must keep the lines of the code, adding styles only.
+// A highlighter based on chroma, for example, can be plugged with SetHighlighter.
+type Highlighter interface {
+	Highlight(code, language string) string
+}
+
+// HighlighterFunc type is a function that implements the Highlighter interface.
+type HighlighterFunc func(code, language string) string
+
+// Highlight method calls the function.
+func (f HighlighterFunc) Highlight(code, language string) string {
+	return f(code, language)
+}
+
+// highlighter
+var (
+	highlighterMu sync.RWMutex
+	highlighter   Highlighter
+)
+
+// SetHighlighter function sets the syntax highlighter used by CodeBlock.
+// A nil highlighter disables the syntax highlighting (the default).
+func SetHighlighter(h Highlighter) {
+	highlighterMu.Lock()
+	defer highlighterMu.Unlock()
+	highlighter = h
+}
+
+// ColorCodeBackground is the background color of the code blocks.
+var ColorCodeBackground = lipgloss.AdaptiveColor{Light: "255", Dark: "236"}
+
+// CodeBlock function renders a block of source code with line numbers on a background panel.
+// It takes the code, its language and a list of style options as input.
+// The code is highlighted with the highlighter set with SetHighlighter, if
+// any; otherwise it is rendered with the bright color. The tabs are expanded
+// to 4 spaces and the trailing newline is removed.
+// The style options are applied to the panel, after the default background
+// and padding, so they can override them.
+func CodeBlock(code, language string, options ...StyleOption) string {
+	code = strings.TrimSuffix(strings.ReplaceAll(code, "\t", "    "), "\n")
+
+	highlighterMu.RLock()
+	h := highlighter
+	highlighterMu.RUnlock()
+	if h != nil {
+		code = carryStyles(h.Highlight(code, language))
+	} else {
+		code = lipgloss.NewStyle().Foreground(CurrentPalette().Bright).Render(code)
+	}
+
+	lines := strings.Split(code, "\n")
+	digits := len(strconv.Itoa(len(lines)))
+	number := lipgloss.NewStyle().Foreground(CurrentPalette().Muted).Width(digits).Align(lipgloss.Right)
+	gap := strings.Repeat(" ", Spacing(2))
+	for i, line := range lines {
+		lines[i] = number.Render(strconv.Itoa(i+1)) + gap + line
+	}
+
+	return NewStyle(append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		return s.Background(ColorCodeBackground).Padding(0, Spacing(1))
+	}}, options...)...).Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,37 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCodeBlock(t *testing.T) {
+	tests := []struct {
+		code        string
+		highlighter Highlighter
+		expected    string
+	}{
+		{
+			code:     "a := 1\nb := 2\n",
+			expected: " 1  a := 1 \n 2  b := 2 ",
+		},
+		{
+			code:     "if ok {\n\treturn\n}",
+			expected: " 1  if ok {    \n 2      return \n 3  }          ",
+		},
+		{
+			code:        "func",
+			highlighter: HighlighterFunc(func(code, _ string) string { return strings.ToUpper(code) }),
+			expected:    " 1  FUNC ",
+		},
+	}
+
+	defer SetHighlighter(nil)
+	for _, test := range tests {
+		SetHighlighter(test.highlighter)
+		result := CodeBlock(test.code, "go")
+		if result != test.expected {
+			t.Errorf("CodeBlock(%q, %q) = %q; expected %q", test.code, "go", result, test.expected)
+		}
+	}
+}
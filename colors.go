@@ -3,6 +3,9 @@ package tui
 import "github.com/charmbracelet/lipgloss"
 
 // colors
+// The colors are the palette of DefaultTheme. They are not updated when the
+// theme changes: use CurrentPalette to read the colors in use, and SetTheme
+// to change them.
 var (
 	ColorAccent     = lipgloss.AdaptiveColor{Light: "201", Dark: "213"}
 	ColorBright     = lipgloss.AdaptiveColor{Light: "0", Dark: "15"}
@@ -14,3 +17,45 @@ var (
 	ColorInfo       = lipgloss.AdaptiveColor{Light: "33", Dark: "45"}
 	ColorLink       = lipgloss.AdaptiveColor{Light: "27", Dark: "33"}
 )
+
+// namedColor type associates a palette color with its name.
+type namedColor struct {
+	name  string
+	color *lipgloss.AdaptiveColor
+}
+
+// color-blind safe colors, based on the Okabe-Ito palette
+var colorBlindPalette = map[string]lipgloss.AdaptiveColor{
+	"Accent":  {Light: "#AA4499", Dark: "#CC79A7"},
+	"Error":   {Light: "#B34700", Dark: "#D55E00"},
+	"Success": {Light: "#00785A", Dark: "#009E73"},
+	"Warning": {Light: "#A66F00", Dark: "#E69F00"},
+	"Info":    {Light: "#2A7FB0", Dark: "#56B4E9"},
+	"Link":    {Light: "#0072B2", Dark: "#3B9AD9"},
+}
+
+// palette modes
+var (
+	highContrast   bool
+	colorBlindSafe bool
+)
+
+// SetColorBlindSafe function toggles the color-blind safe mode.
+// When enabled, the accent and status colors of the current theme are remapped
+// to a palette that stays distinguishable with the most common color vision
+// deficiencies. When disabled, the colors of the current theme are restored.
+// It can be combined with the high contrast mode (see SetHighContrast).
+func SetColorBlindSafe(enabled bool) {
+	changeTheme(func() {
+		colorBlindSafe = enabled
+	})
+}
+
+// colorBlindColor function returns the color-blind safe variant of the named
+// color, or the color itself if the color-blind palette does not remap it.
+func colorBlindColor(name string, c lipgloss.AdaptiveColor) lipgloss.AdaptiveColor {
+	if cb, ok := colorBlindPalette[name]; ok {
+		return cb
+	}
+	return c
+}
@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// MultiColumn function flows a text across a number of columns (newspaper layout).
+// It takes a text, the number of columns and the total width as input.
+// The text is wrapped to the width of a column and its lines are
+// distributed in order across the columns, so that all the columns have the
+// same height (except possibly the last one, which is shorter).
+// The columns are separated by a gap of 2 cells (adjusted to the density of
+// the theme, see Spacing).
+// If the width is less than or equal to 0, the width of the terminal is used.
+// If the number of columns is less than 1, it is set to 1.
+func MultiColumn(text string, columns, width int) string {
+	columns = max(columns, 1)
+	if width <= 0 {
+		width, _ = getTerminalSize()
+	}
+
+	gap := Spacing(2)
+	colWidth := max((width-gap*(columns-1))/columns, 1)
+	lines := strings.Split(WrapString(text, colWidth), "\n")
+	height := (len(lines) + columns - 1) / columns
+
+	blocks := make([]string, 0, columns*2)
+	for c := 0; c < columns && c*height < len(lines); c++ {
+		col := lines[c*height : min((c+1)*height, len(lines))]
+		for i, line := range col {
+			col[i] = line + strings.Repeat(" ", max(colWidth-ansi.StringWidth(line), 0))
+		}
+
+		if c > 0 {
+			blocks = append(blocks, strings.Repeat(" ", gap))
+		}
+		blocks = append(blocks, strings.Join(col, "\n"))
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, blocks...)
+}
+
+// Columns function distributes rendered items across columns (newspaper layout).
+// It takes the number of columns and the items as input.
+// The items keep their order, top to bottom and then left to right, and are
+// split so that the tallest column is as short as possible.
+// The items of a column are separated by an empty line and the columns by a
+// gap of 2 (both adjusted to the density of the theme, see Spacing).
+// If the number of columns is less than 1, it is set to 1.
+func Columns(n int, items ...string) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	gap := Spacing(1)
+	heights := make([]int, len(items))
+	lower, upper := 0, 0
+	for i, item := range items {
+		heights[i] = lipgloss.Height(item)
+		lower = max(lower, heights[i])
+		upper += heights[i] + gap
+	}
+
+	// binary search the smallest column height that fits the items in n columns
+	n = max(n, 1)
+	for lower < upper {
+		limit := (lower + upper) / 2
+		if len(splitColumns(heights, gap, limit)) <= n {
+			upper = limit
+		} else {
+			lower = limit + 1
+		}
+	}
+
+	sep := strings.Repeat(" ", Spacing(2))
+	blocks := make([]string, 0, n*2)
+	start := 0
+	for i, end := range splitColumns(heights, gap, lower) {
+		if i > 0 {
+			blocks = append(blocks, sep)
+		}
+		blocks = append(blocks, strings.Join(items[start:end], strings.Repeat("\n", gap+1)))
+		start = end
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, blocks...)
+}
+
+// splitColumns function fills the columns in order without exceeding the
+// height limit, and returns the index after the last item of each column.
+// The items are separated by gap lines.
+func splitColumns(heights []int, gap, limit int) []int {
+	ends := make([]int, 0)
+	height := 0
+	for i, h := range heights {
+		if i > 0 && height > 0 && height+gap+h > limit {
+			ends = append(ends, i)
+			height = 0
+		}
+		if height > 0 {
+			height += gap
+		}
+		height += h
+	}
+
+	return append(ends, len(heights))
+}
+
+// flow function lays out single-line items from left to right, separated by
+// a gap, wrapping them on a new line when they do not fit the width.
+func flow(width, gap int, items ...string) string {
+	sep := strings.Repeat(" ", gap)
+	lines := make([]string, 0, 1)
+	line, lineWidth := "", 0
+	for _, item := range items {
+		w := lipgloss.Width(item)
+		if lineWidth > 0 && lineWidth+gap+w > width {
+			lines = append(lines, line)
+			line, lineWidth = "", 0
+		}
+		if lineWidth > 0 {
+			line += sep
+			lineWidth += gap
+		}
+		line += item
+		lineWidth += w
+	}
+	if lineWidth > 0 {
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestMultiColumn(t *testing.T) {
+	tests := []struct {
+		text     string
+		columns  int
+		width    int
+		expected string
+	}{
+		{
+			text:     "one two three four five six",
+			columns:  2,
+			width:    12,
+			expected: "one    four \ntwo    five \nthree  six  ",
+		},
+		{
+			text:     "one two three",
+			columns:  0,
+			width:    5,
+			expected: "one  \ntwo  \nthree",
+		},
+		{
+			text:     "one two",
+			columns:  3,
+			width:    13,
+			expected: "one  two",
+		},
+	}
+
+	for _, test := range tests {
+		result := MultiColumn(test.text, test.columns, test.width)
+		if result != test.expected {
+			t.Errorf("MultiColumn(%q, %d, %d) = %q; expected %q", test.text, test.columns, test.width, result, test.expected)
+		}
+	}
+}
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		n        int
+		items    []string
+		expected string
+	}{
+		{
+			n:        2,
+			items:    []string{},
+			expected: "",
+		},
+		{
+			n:        2,
+			items:    []string{"a", "b", "c", "d"},
+			expected: "a  c\n    \nb  d",
+		},
+		{
+			n:        2,
+			items:    []string{"a\na\na", "b", "c"},
+			expected: "a  b\na   \na  c",
+		},
+		{
+			n:        0,
+			items:    []string{"a", "b"},
+			expected: "a\n\nb",
+		},
+	}
+
+	for _, test := range tests {
+		result := Columns(test.n, test.items...)
+		if result != test.expected {
+			t.Errorf("Columns(%d, %q) = %q; expected %q", test.n, test.items, result, test.expected)
+		}
+	}
+}
@@ -33,6 +33,33 @@ func NewStyle(options ...StyleOption) lipgloss.Style {
 	return s
 }
 
+// NewStyleWith function returns a lipgloss style bound to a specific renderer.
+// It works like NewStyle, but the color profile and the background detection
+// of the provided renderer are used instead of the default ones (e.g. to
+// render for an SSH session, where each client has its own terminal).
+// The adaptive colors of the theme are resolved against the renderer.
+// If the renderer is nil, the default renderer is used.
+//
+// Only the styles built with NewStyleWith and RenderWith are bound to the
+// renderer. The other helpers of the package (e.g. RenderStatus, Badge,
+// Card, Panel, Rule, CodeBlock and GradientText) always render with the
+// default renderer (see SetOutput), even when they take style options.
+func NewStyleWith(r *lipgloss.Renderer, options ...StyleOption) lipgloss.Style {
+	if r == nil {
+		return NewStyle(options...)
+	}
+
+	s := r.NewStyle()
+	Config(&s, options...)
+	return s
+}
+
+// RenderWith function returns a string styled for a specific renderer.
+// It works like Render, using NewStyleWith to build the style.
+func RenderWith(r *lipgloss.Renderer, text string, options ...StyleOption) string {
+	return NewStyleWith(r, options...).Render(text)
+}
+
 // Render function returns a styled string.
 // It takes a string and a list of style options as input and returns a styled string.
 // The style options are functions that take a lipgloss style as input and return a lipgloss style.
@@ -65,3 +92,33 @@ func Concat(s *lipgloss.Style, strs ...string) {
 func ConcatLn(s *lipgloss.Style, strs ...string) {
 	ConcatWith(s, "\n", strs...)
 }
+
+// Compose function returns a style option that applies a list of style options in order.
+// It takes a list of style options as input and returns a single style option.
+// It is useful to build reusable style pipelines.
+// Example:
+//
+//	title := Compose(opts.Bold, opts.Accent, opts.Upper)
+//	Render("hello", title)
+func Compose(options ...StyleOption) StyleOption {
+	return func(s lipgloss.Style) lipgloss.Style {
+		for _, option := range options {
+			s = option(s)
+		}
+		return s
+	}
+}
+
+// When function returns a style option that applies the provided style option
+// only if the condition is true. Otherwise the style is returned unchanged.
+// Example:
+//
+//	Render(item, When(selected, opts.Accent))
+func When(cond bool, option StyleOption) StyleOption {
+	return func(s lipgloss.Style) lipgloss.Style {
+		if !cond || option == nil {
+			return s
+		}
+		return option(s)
+	}
+}
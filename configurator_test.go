@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestCompose(t *testing.T) {
+	bold := func(s lipgloss.Style) lipgloss.Style { return s.Bold(true) }
+	width := func(s lipgloss.Style) lipgloss.Style { return s.Width(10) }
+
+	s := NewStyle(Compose(bold, width))
+	if !s.GetBold() || s.GetWidth() != 10 {
+		t.Errorf("Compose(bold, width) = bold %v, width %d; expected bold true, width 10", s.GetBold(), s.GetWidth())
+	}
+
+	if s := NewStyle(Compose()); s.GetBold() {
+		t.Errorf("Compose() changed the style")
+	}
+}
+
+func TestWhen(t *testing.T) {
+	bold := func(s lipgloss.Style) lipgloss.Style { return s.Bold(true) }
+	tests := []struct {
+		cond     bool
+		option   StyleOption
+		expected bool
+	}{
+		{
+			cond:     true,
+			option:   bold,
+			expected: true,
+		},
+		{
+			cond:     false,
+			option:   bold,
+			expected: false,
+		},
+		{
+			cond:     true,
+			option:   nil,
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		result := NewStyle(When(test.cond, test.option)).GetBold()
+		if result != test.expected {
+			t.Errorf("When(%v, option) bold = %v; expected %v", test.cond, result, test.expected)
+		}
+	}
+}
+
+func TestRenderWith(t *testing.T) {
+	bold := func(s lipgloss.Style) lipgloss.Style { return s.Bold(true) }
+
+	var b strings.Builder
+	r := lipgloss.NewRenderer(&b)
+	r.SetColorProfile(termenv.TrueColor)
+	if result := RenderWith(r, "text", bold); result != "\x1b[1mtext\x1b[0m" {
+		t.Errorf("RenderWith(truecolor renderer, %q, bold) = %q; expected %q", "text", result, "\x1b[1mtext\x1b[0m")
+	}
+
+	if result := RenderWith(nil, "text", bold); result != Render("text", bold) {
+		t.Errorf("RenderWith(nil, %q, bold) = %q; expected %q", "text", result, Render("text", bold))
+	}
+}
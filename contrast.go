@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/muesli/termenv"
+)
+
+// contrast thresholds
+const (
+	// ContrastAA is the minimum contrast ratio recommended by WCAG (level AA) for normal text.
+	ContrastAA = 4.5
+
+	// ContrastAAA is the minimum contrast ratio recommended by WCAG (level AAA) for normal text.
+	ContrastAAA = 7.0
+)
+
+// reference backgrounds used to check the adaptive colors of the palette
+var (
+	lightBackground = lipgloss.Color("#ffffff")
+	darkBackground  = lipgloss.Color("#000000")
+)
+
+// ContrastWarning type describes a palette color that does not reach the
+// requested contrast ratio against its reference background.
+type ContrastWarning struct {
+	Name    string  // the name of the palette color (e.g. "Accent")
+	Dark    bool    // true if the warning refers to the dark variant of the color
+	Color   string  // the offending color value
+	Ratio   float64 // the computed contrast ratio
+	Minimum float64 // the requested minimum contrast ratio
+}
+
+// ContrastRatio function returns the contrast ratio between two colors.
+// It takes a foreground and a background color as input (hex values or ANSI codes)
+// and returns a value between 1 (no contrast) and 21 (black on white).
+// The ratio is computed using the WCAG relative luminance formula.
+// If one of the colors cannot be parsed, it returns 0.
+func ContrastRatio(fg, bg lipgloss.Color) float64 {
+	f, ok := colorToRGB(fg)
+	if !ok {
+		return 0
+	}
+	b, ok := colorToRGB(bg)
+	if !ok {
+		return 0
+	}
+
+	return luminanceRatio(luminance(f), luminance(b))
+}
+
+// EnsureContrast function returns a color that reaches at least the
+// minimum contrast ratio against the background color.
+// It takes a foreground color, a background color and the minimum ratio as input.
+// If the foreground color already reaches the minimum ratio, it is returned as is.
+// Otherwise the lightness of the foreground color is moved away from the
+// background (lighter on dark backgrounds, darker on light ones) until the
+// ratio is reached, and the adjusted color is returned as a hex value.
+// If one of the colors cannot be parsed, the foreground color is returned as is.
+func EnsureContrast(fg, bg lipgloss.Color, minimum float64) lipgloss.Color {
+	f, ok := colorToRGB(fg)
+	if !ok {
+		return fg
+	}
+	b, ok := colorToRGB(bg)
+	if !ok {
+		return fg
+	}
+
+	bl := luminance(b)
+	if luminanceRatio(luminance(f), bl) >= minimum {
+		return fg
+	}
+
+	// move the lightness one percent at a time towards white or black
+	// depending on the luminance of the background
+	step := -0.01
+	if bl < 0.5 {
+		step = 0.01
+	}
+
+	h, s, l := f.Hsl()
+	for l >= 0 && l <= 1 {
+		l += step
+		f = colorful.Hsl(h, s, min(max(l, 0), 1)).Clamped()
+		if luminanceRatio(luminance(f), bl) >= minimum {
+			break
+		}
+	}
+
+	return lipgloss.Color(f.Hex())
+}
+
+// CheckContrast function checks the contrast of the palette colors.
+// It takes the minimum contrast ratio as input and returns a warning for
+// every palette color that does not reach it.
+// The light variant of each color is checked against a white background,
+// the dark variant against a black background.
+func CheckContrast(minimum float64) []ContrastWarning {
+	warnings := make([]ContrastWarning, 0)
+	p := CurrentPalette()
+	for _, c := range p.palette() {
+		if r := ContrastRatio(lipgloss.Color(c.color.Light), lightBackground); r < minimum {
+			warnings = append(warnings, ContrastWarning{Name: c.name, Color: c.color.Light, Ratio: r, Minimum: minimum})
+		}
+		if r := ContrastRatio(lipgloss.Color(c.color.Dark), darkBackground); r < minimum {
+			warnings = append(warnings, ContrastWarning{Name: c.name, Dark: true, Color: c.color.Dark, Ratio: r, Minimum: minimum})
+		}
+	}
+
+	return warnings
+}
+
+// SetHighContrast function toggles the high contrast mode.
+// When enabled, every palette color is remapped so that it reaches the
+// ContrastAAA ratio against its reference background.
+// When disabled, the colors of the current theme are restored.
+func SetHighContrast(enabled bool) {
+	changeTheme(func() {
+		highContrast = enabled
+	})
+}
+
+// highContrastColor function returns the color adjusted to reach the
+// ContrastAAA ratio against the reference backgrounds.
+// The name of the color is ignored: it is accepted to match Theme.remap.
+func highContrastColor(_ string, c lipgloss.AdaptiveColor) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{
+		Light: string(EnsureContrast(lipgloss.Color(c.Light), lightBackground, ContrastAAA)),
+		Dark:  string(EnsureContrast(lipgloss.Color(c.Dark), darkBackground, ContrastAAA)),
+	}
+}
+
+// colorToRGB function converts a lipgloss color to a colorful color.
+// It returns false if the color cannot be parsed.
+// The conversion does not depend on the color profile of the terminal.
+func colorToRGB(c lipgloss.Color) (colorful.Color, bool) {
+	tc := termenv.TrueColor.Color(string(c))
+	if tc == nil {
+		return colorful.Color{}, false
+	}
+
+	return termenv.ConvertToRGB(tc), true
+}
+
+// luminance function returns the WCAG relative luminance of a color.
+func luminance(c colorful.Color) float64 {
+	r, g, b := c.LinearRgb()
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// luminanceRatio function returns the contrast ratio between two relative luminances.
+func luminanceRatio(a, b float64) float64 {
+	if a < b {
+		a, b = b, a
+	}
+
+	return (a + 0.05) / (b + 0.05)
+}
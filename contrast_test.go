@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"math"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		fg       lipgloss.Color
+		bg       lipgloss.Color
+		expected float64
+	}{
+		{
+			fg:       "#000000",
+			bg:       "#ffffff",
+			expected: 21,
+		},
+		{
+			fg:       "#ffffff",
+			bg:       "#000000",
+			expected: 21,
+		},
+		{
+			fg:       "15",
+			bg:       "15",
+			expected: 1,
+		},
+		{
+			fg:       "not a color",
+			bg:       "#000000",
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		result := ContrastRatio(test.fg, test.bg)
+		if math.Abs(result-test.expected) > 0.01 {
+			t.Errorf("ContrastRatio(%q, %q) = %.2f; expected %.2f", test.fg, test.bg, result, test.expected)
+		}
+	}
+}
+
+func TestEnsureContrast(t *testing.T) {
+	tests := []struct {
+		fg lipgloss.Color
+		bg lipgloss.Color
+	}{
+		{
+			fg: "#777777",
+			bg: "#ffffff",
+		},
+		{
+			fg: "#333333",
+			bg: "#000000",
+		},
+		{
+			fg: "241",
+			bg: "#000000",
+		},
+	}
+
+	for _, test := range tests {
+		result := EnsureContrast(test.fg, test.bg, ContrastAAA)
+		if r := ContrastRatio(result, test.bg); r < ContrastAAA {
+			t.Errorf("EnsureContrast(%q, %q) = %q with ratio %.2f; expected at least %.2f", test.fg, test.bg, result, r, ContrastAAA)
+		}
+	}
+
+	if result := EnsureContrast("#000000", "#ffffff", ContrastAAA); result != "#000000" {
+		t.Errorf("EnsureContrast(%q, %q) = %q; expected the color unchanged", "#000000", "#ffffff", result)
+	}
+}
+
+func TestSetHighContrast(t *testing.T) {
+	before := CurrentPalette().Muted
+	SetHighContrast(true)
+	if len(CheckContrast(ContrastAAA)) != 0 {
+		t.Errorf("CheckContrast(%.2f) returned warnings in high contrast mode", ContrastAAA)
+	}
+
+	SetHighContrast(false)
+	if CurrentPalette().Muted != before {
+		t.Errorf("SetHighContrast(false) did not restore the palette: %v; expected %v", CurrentPalette().Muted, before)
+	}
+}
+
+func TestPaletteModes(t *testing.T) {
+	before := CurrentPalette().Error
+	SetColorBlindSafe(true)
+	if CurrentPalette().Error != colorBlindPalette["Error"] {
+		t.Errorf("SetColorBlindSafe(true) set CurrentPalette().Error to %v; expected %v", CurrentPalette().Error, colorBlindPalette["Error"])
+	}
+
+	SetHighContrast(true)
+	SetColorBlindSafe(false)
+	if CurrentPalette().Error != highContrastColor("Error", before) {
+		t.Errorf("SetColorBlindSafe(false) in high contrast mode set CurrentPalette().Error to %v; expected %v", CurrentPalette().Error, highContrastColor("Error", before))
+	}
+
+	SetHighContrast(false)
+	if CurrentPalette().Error != before {
+		t.Errorf("disabling all the palette modes set CurrentPalette().Error to %v; expected %v", CurrentPalette().Error, before)
+	}
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// CrashOption type is a function that configures the crash handler (see HandleCrash).
+type CrashOption func(*crashConfig)
+
+// crashConfig holds the configuration of the crash handler.
+type crashConfig struct {
+	version string
+	file    string
+	context func() []string
+	out     io.Writer
+	exit    func(int)
+}
+
+// CrashVersion returns a crash option that adds the version of the application to the crash report.
+func CrashVersion(version string) CrashOption {
+	return func(c *crashConfig) {
+		c.version = version
+	}
+}
+
+// CrashFile returns a crash option that also writes the crash report, without
+// styles, to the file at the provided path (useful for bug reports).
+func CrashFile(path string) CrashOption {
+	return func(c *crashConfig) {
+		c.file = path
+	}
+}
+
+// CrashContext returns a crash option that adds the lines returned by the
+// provided function to the crash report (e.g. the last messages handled by the application).
+func CrashContext(f func() []string) CrashOption {
+	return func(c *crashConfig) {
+		c.context = f
+	}
+}
+
+// HandleCrash function recovers from a panic and renders a crash report.
+// It must be deferred directly, usually as the first statement of main:
+//
+//	func main() {
+//		defer tui.HandleCrash(tui.CrashVersion("1.2.0"), tui.CrashFile("crash.log"))
+//		...
+//	}
+//
+// When a panic occurs, it restores the terminal (shows the cursor, leaves the
+// alternate screen and resets the styles), renders the panic value with the
+// version, the goroutine stack and the optional context to stderr, writes
+// the report to the crash file if configured and exits with status 2.
+// If no panic occurs, it does nothing.
+func HandleCrash(options ...CrashOption) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	config := &crashConfig{out: os.Stderr, exit: os.Exit}
+	for _, option := range options {
+		option(config)
+	}
+
+	handleCrash(r, debug.Stack(), config)
+}
+
+// handleCrash function renders the crash report of a recovered panic.
+func handleCrash(r any, stack []byte, config *crashConfig) {
+	// restore the terminal
+	fmt.Fprint(config.out, ansi.ResetStyle+ansi.ShowCursor+ansi.DisableAltScreenBuffer)
+
+	err, ok := r.(error)
+	if !ok {
+		err = fmt.Errorf("%v", r)
+	}
+
+	muted := lipgloss.NewStyle().Foreground(CurrentPalette().Muted)
+	lines := []string{
+		lipgloss.NewStyle().Foreground(CurrentPalette().Error).Bold(true).Render("The application crashed"),
+		muted.Render(fmt.Sprintf("%s · %s/%s · %s", time.Now().Format(time.RFC3339), runtime.GOOS, runtime.GOARCH, runtime.Version())),
+	}
+	if config.version != "" {
+		lines = append(lines, muted.Render("version "+config.version))
+	}
+
+	lines = append(lines, "", RenderError(err, WithStack(stack)))
+
+	if config.context != nil {
+		if context := config.context(); len(context) > 0 {
+			lines = append(lines, "", muted.Bold(true).Render("Context:"))
+			for _, line := range context {
+				lines = append(lines, muted.Render("  "+line))
+			}
+		}
+	}
+
+	report := strings.Join(lines, "\n")
+	if config.file != "" {
+		if writeErr := os.WriteFile(config.file, []byte(ansi.Strip(report)+"\n"), 0o644); writeErr != nil {
+			report += "\n\n" + RenderError(fmt.Errorf("writing the crash report: %w", writeErr))
+		} else {
+			report += "\n\n" + muted.Render("The crash report was written to "+config.file)
+		}
+	}
+
+	fmt.Fprintln(config.out, report)
+	config.exit(2)
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHandleCrash(t *testing.T) {
+	var out bytes.Buffer
+	code := -1
+	file := filepath.Join(t.TempDir(), "crash.log")
+	config := &crashConfig{out: &out, exit: func(c int) { code = c }}
+	for _, option := range []CrashOption{
+		CrashVersion("1.2.0"),
+		CrashFile(file),
+		CrashContext(func() []string { return []string{"last key: q"} }),
+	} {
+		option(config)
+	}
+
+	handleCrash("boom", []byte("main.go:1"), config)
+
+	if code != 2 {
+		t.Errorf("crash handler exited with status %d; expected %d", code, 2)
+	}
+	for _, expected := range []string{"boom", "version 1.2.0", "main.go:1", "last key: q", file} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("crash report %q does not contain %q", out.String(), expected)
+		}
+	}
+
+	report, err := os.ReadFile(file)
+	if err != nil || !strings.Contains(string(report), "boom") {
+		t.Errorf("crash file contains %q, %v; expected the crash report", report, err)
+	}
+}
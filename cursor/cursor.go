@@ -0,0 +1,115 @@
+package cursor
+
+import "github.com/charmbracelet/x/ansi"
+
+// Shape type represents the shape of the terminal cursor.
+type Shape int
+
+// cursor shapes
+const (
+	Block Shape = iota
+	Underline
+	Bar
+)
+
+// MoveTo function returns the sequence that moves the cursor to a cell.
+// It takes the column and the row of the cell as input, both starting from 0.
+// Negative values are treated as 0.
+func MoveTo(x, y int) string {
+	return ansi.SetCursorPosition(max(x, 0)+1, max(y, 0)+1)
+}
+
+// Up function returns the sequence that moves the cursor up by n rows.
+// It returns an empty string if n is 0 or less.
+func Up(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	return ansi.CursorUp(n)
+}
+
+// Down function returns the sequence that moves the cursor down by n rows.
+// It returns an empty string if n is 0 or less.
+func Down(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	return ansi.CursorDown(n)
+}
+
+// Left function returns the sequence that moves the cursor left by n columns.
+// It returns an empty string if n is 0 or less.
+func Left(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	return ansi.CursorLeft(n)
+}
+
+// Right function returns the sequence that moves the cursor right by n columns.
+// It returns an empty string if n is 0 or less.
+func Right(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	return ansi.CursorRight(n)
+}
+
+// Save function returns the sequence that saves the current cursor position.
+func Save() string {
+	return ansi.SaveCursor
+}
+
+// Restore function returns the sequence that restores the cursor position
+// saved with Save.
+func Restore() string {
+	return ansi.RestoreCursor
+}
+
+// SaveRestore function wraps a string between the save and restore sequences.
+// It takes a string as input (usually a movement followed by some content)
+// and returns a string that leaves the cursor where it was once printed.
+// Example:
+//
+//	SaveRestore(MoveTo(0, 0) + "status") // prints "status" in the top left corner
+func SaveRestore(s string) string {
+	return Save() + s + Restore()
+}
+
+// Show function returns the sequence that shows the cursor.
+func Show() string {
+	return ansi.ShowCursor
+}
+
+// Hide function returns the sequence that hides the cursor.
+func Hide() string {
+	return ansi.HideCursor
+}
+
+// SetShape function returns the sequence that changes the cursor shape.
+// It takes a shape and a blink flag as input.
+// Terminals that do not support the sequence ignore it.
+func SetShape(shape Shape, blink bool) string {
+	style := 2 // steady block
+	switch shape {
+	case Underline:
+		style = 4
+	case Bar:
+		style = 6
+	}
+	if blink {
+		style--
+	}
+
+	return ansi.SetCursorStyle(style)
+}
+
+// Reset function returns the sequence that restores the default cursor shape
+// of the terminal.
+func Reset() string {
+	return ansi.SetCursorStyle(0)
+}
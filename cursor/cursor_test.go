@@ -0,0 +1,81 @@
+package cursor
+
+import "testing"
+
+func TestMoveTo(t *testing.T) {
+	tests := []struct {
+		x, y     int
+		expected string
+	}{
+		{x: 0, y: 0, expected: "\x1b[1;1H"},
+		{x: 4, y: 2, expected: "\x1b[3;5H"},
+		{x: -3, y: 2, expected: "\x1b[3;1H"},
+		{x: 4, y: -1, expected: "\x1b[1;5H"},
+	}
+
+	for _, test := range tests {
+		result := MoveTo(test.x, test.y)
+		if result != test.expected {
+			t.Errorf("MoveTo(%d, %d) = %q; expected %q", test.x, test.y, result, test.expected)
+		}
+	}
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		move     func(int) string
+		n        int
+		expected string
+	}{
+		{name: "Up", move: Up, n: 2, expected: "\x1b[2A"},
+		{name: "Down", move: Down, n: 3, expected: "\x1b[3B"},
+		{name: "Right", move: Right, n: 4, expected: "\x1b[4C"},
+		{name: "Left", move: Left, n: 5, expected: "\x1b[5D"},
+		{name: "Up", move: Up, n: 0, expected: ""},
+		{name: "Down", move: Down, n: -1, expected: ""},
+		{name: "Right", move: Right, n: 0, expected: ""},
+		{name: "Left", move: Left, n: -2, expected: ""},
+	}
+
+	for _, test := range tests {
+		result := test.move(test.n)
+		if result != test.expected {
+			t.Errorf("%s(%d) = %q; expected %q", test.name, test.n, result, test.expected)
+		}
+	}
+}
+
+func TestSetShape(t *testing.T) {
+	tests := []struct {
+		shape    Shape
+		blink    bool
+		expected string
+	}{
+		{shape: Block, blink: true, expected: "\x1b[1 q"},
+		{shape: Block, blink: false, expected: "\x1b[2 q"},
+		{shape: Underline, blink: true, expected: "\x1b[3 q"},
+		{shape: Underline, blink: false, expected: "\x1b[4 q"},
+		{shape: Bar, blink: true, expected: "\x1b[5 q"},
+		{shape: Bar, blink: false, expected: "\x1b[6 q"},
+	}
+
+	for _, test := range tests {
+		result := SetShape(test.shape, test.blink)
+		if result != test.expected {
+			t.Errorf("SetShape(%d, %v) = %q; expected %q", test.shape, test.blink, result, test.expected)
+		}
+	}
+
+	if result := Reset(); result != "\x1b[0 q" {
+		t.Errorf("Reset() = %q; expected %q", result, "\x1b[0 q")
+	}
+}
+
+func TestSaveRestore(t *testing.T) {
+	result := SaveRestore(MoveTo(0, 0) + "status")
+	expected := "\x1b7\x1b[1;1Hstatus\x1b8"
+	if result != expected {
+		t.Errorf("SaveRestore(%q) = %q; expected %q", MoveTo(0, 0)+"status", result, expected)
+	}
+}
@@ -0,0 +1,267 @@
+package diagram
+
+import (
+	"strings"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/canvas"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// direction type is the direction of a connector segment.
+type direction int
+
+// directions
+const (
+	right direction = iota
+	left
+	down
+	up
+)
+
+// opposite method returns the opposite direction.
+func (d direction) opposite() direction {
+	return [...]direction{left, right, up, down}[d]
+}
+
+// point type is a cell of the diagram.
+type point struct {
+	x, y int
+}
+
+// box type is a labeled box of the diagram.
+type box struct {
+	label string
+	x, y  int
+	w, h  int
+}
+
+// edge type is an arrow connecting two boxes.
+type edge struct {
+	from, to int
+}
+
+// Diagram type represents a diagram of labeled boxes connected by arrows,
+// drawn on a canvas (see canvas.Canvas) (e.g. a small architecture or pipeline diagram).
+// The boxes are placed at explicit positions and the arrows are routed
+// automatically with orthogonal connectors.
+// A Diagram is rendered with the String method.
+type Diagram struct {
+	boxes []box
+	ids   map[string]int
+	edges []edge
+}
+
+// New function returns a new empty diagram.
+func New() *Diagram {
+	return &Diagram{
+		boxes: make([]box, 0),
+		ids:   make(map[string]int),
+		edges: make([]edge, 0),
+	}
+}
+
+// Box method adds a box to the diagram.
+// It takes the identifier of the box (used by Connect), its label and the
+// column and row of its top-left corner (starting from 0) as input.
+// The box is sized to fit its label, which can span several lines; wide
+// runes (e.g. CJK runes) take two cells, both in the size and on the canvas.
+// A box with the same identifier is replaced.
+func (d *Diagram) Box(id, label string, x, y int) *Diagram {
+	b := box{
+		label: label,
+		x:     max(x, 0),
+		y:     max(y, 0),
+		w:     lipgloss.Width(label) + 4,
+		h:     lipgloss.Height(label) + 2,
+	}
+
+	if i, ok := d.ids[id]; ok {
+		d.boxes[i] = b
+		return d
+	}
+	d.ids[id] = len(d.boxes)
+	d.boxes = append(d.boxes, b)
+	return d
+}
+
+// Connect method adds an arrow from a box to another.
+// It takes the identifiers of the boxes as input; unknown identifiers are ignored.
+// The arrow leaves the side of the first box facing the second one: boxes
+// side by side are connected horizontally, boxes one above the other
+// vertically. Overlapping boxes are not connected.
+func (d *Diagram) Connect(from, to string) *Diagram {
+	f, ok := d.ids[from]
+	if !ok {
+		return d
+	}
+	t, ok := d.ids[to]
+	if !ok {
+		return d
+	}
+
+	d.edges = append(d.edges, edge{from: f, to: t})
+	return d
+}
+
+// String method renders the diagram.
+func (d *Diagram) String() string {
+	width, height := 0, 0
+	for _, b := range d.boxes {
+		width = max(width, b.x+b.w)
+		height = max(height, b.y+b.h)
+	}
+
+	c := canvas.New(width, height)
+	lineStyle := lipgloss.NewStyle().Foreground(tui.CurrentPalette().LightMuted)
+	// the arrow heads are drawn after all the connectors, so that they are
+	// not hidden by the connectors crossing them
+	heads := make([]func(), 0, len(d.edges))
+	for _, e := range d.edges {
+		if path, head := route(d.boxes[e.from], d.boxes[e.to]); len(path) > 0 {
+			drawPath(c, path, lineStyle)
+			heads = append(heads, func() { drawHead(c, path, head, lineStyle) })
+		}
+	}
+	for _, f := range heads {
+		f()
+	}
+
+	borderStyle := lipgloss.NewStyle().Foreground(tui.CurrentPalette().Muted)
+	labelStyle := lipgloss.NewStyle().Foreground(tui.CurrentPalette().Bright)
+	for _, b := range d.boxes {
+		drawBox(c, b, borderStyle, labelStyle)
+	}
+
+	return c.String()
+}
+
+// route function returns the path of the arrow connecting two boxes and the
+// direction of its head. The path starts next to the first box and ends next
+// to the second one; it is empty if the boxes overlap or touch.
+func route(a, b box) ([]point, direction) {
+	var start, end point
+	var head direction
+	switch {
+	case b.x >= a.x+a.w:
+		start, end, head = point{a.x + a.w, a.y + a.h/2}, point{b.x - 1, b.y + b.h/2}, right
+	case b.x+b.w <= a.x:
+		start, end, head = point{a.x - 1, a.y + a.h/2}, point{b.x + b.w, b.y + b.h/2}, left
+	case b.y >= a.y+a.h:
+		start, end, head = point{a.x + a.w/2, a.y + a.h}, point{b.x + b.w/2, b.y - 1}, down
+	case b.y+b.h <= a.y:
+		start, end, head = point{a.x + a.w/2, a.y - 1}, point{b.x + b.w/2, b.y + b.h}, up
+	default:
+		return nil, head
+	}
+
+	// boxes facing each other are connected by a straight line, through the
+	// middle of the rows or columns they share
+	if head == right || head == left {
+		if top, bottom := max(a.y, b.y), min(a.y+a.h, b.y+b.h); top < bottom {
+			start.y, end.y = (top+bottom-1)/2, (top+bottom-1)/2
+		}
+	} else {
+		start.x, end.x = (max(a.x, b.x)+min(a.x+a.w, b.x+b.w)-1)/2, (max(a.x, b.x)+min(a.x+a.w, b.x+b.w)-1)/2
+	}
+
+	// horizontal connectors turn halfway between the boxes, vertical ones too
+	var points []point
+	if head == right || head == left {
+		if (head == right && end.x < start.x) || (head == left && end.x > start.x) {
+			return nil, head
+		}
+		mid := (start.x + end.x) / 2
+		points = []point{start, {mid, start.y}, {mid, end.y}, end}
+	} else {
+		if (head == down && end.y < start.y) || (head == up && end.y > start.y) {
+			return nil, head
+		}
+		mid := (start.y + end.y) / 2
+		points = []point{start, {start.x, mid}, {end.x, mid}, end}
+	}
+
+	// remove the duplicated points of the degenerate segments
+	path := []point{points[0]}
+	for _, p := range points[1:] {
+		if p != path[len(path)-1] {
+			path = append(path, p)
+		}
+	}
+	return path, head
+}
+
+// drawBox function draws a box with its label centered inside.
+func drawBox(c *canvas.Canvas, b box, borderStyle, labelStyle lipgloss.Style) {
+	c.DrawRect(b.x, b.y, b.w, b.h, lipgloss.RoundedBorder(), borderStyle)
+	for i, line := range strings.Split(b.label, "\n") {
+		c.DrawText(b.x+2+(b.w-4-lipgloss.Width(line))/2, b.y+1+i, line, labelStyle)
+	}
+}
+
+// drawPath function draws an orthogonal connector through the points.
+func drawPath(c *canvas.Canvas, points []point, style lipgloss.Style) {
+	horizontal, vertical, corners, _ := glyphs()
+
+	for i := 0; i < len(points)-1; i++ {
+		a, b := points[i], points[i+1]
+		if a.y == b.y {
+			c.DrawLine(a.x, a.y, b.x, b.y, horizontal, style)
+		} else {
+			c.DrawLine(a.x, a.y, b.x, b.y, vertical, style)
+		}
+	}
+
+	// the corners join the side the segment comes from with the side the
+	// next segment goes to
+	for i := 1; i < len(points)-1; i++ {
+		in, out := towards(points[i-1], points[i]), towards(points[i], points[i+1])
+		if in == out {
+			continue
+		}
+		c.SetCell(points[i].x, points[i].y, corners[[2]direction{in.opposite(), out}], style)
+	}
+}
+
+// drawHead function draws an arrow head at the end of a connector.
+func drawHead(c *canvas.Canvas, points []point, head direction, style lipgloss.Style) {
+	_, _, _, heads := glyphs()
+	end := points[len(points)-1]
+	c.SetCell(end.x, end.y, heads[head], style)
+}
+
+// towards function returns the direction from a point to another on the same row or column.
+func towards(a, b point) direction {
+	switch {
+	case b.x > a.x:
+		return right
+	case b.x < a.x:
+		return left
+	case b.y > a.y:
+		return down
+	default:
+		return up
+	}
+}
+
+// glyphs function returns the symbols used to draw the connectors with the
+// current profile (see tui.CurrentProfile): the horizontal and vertical
+// lines, the corners by the pair of sides they join, and the arrow heads.
+func glyphs() (rune, rune, map[[2]direction]rune, map[direction]rune) {
+	if !tui.CurrentProfile().Unicode {
+		corners := map[[2]direction]rune{}
+		for _, a := range []direction{right, left, down, up} {
+			for _, b := range []direction{right, left, down, up} {
+				corners[[2]direction{a, b}] = '+'
+			}
+		}
+		return '-', '|', corners, map[direction]rune{right: '>', left: '<', down: 'v', up: '^'}
+	}
+
+	return '─', '│', map[[2]direction]rune{
+		{left, down}: '┐', {down, left}: '┐',
+		{left, up}: '┘', {up, left}: '┘',
+		{right, down}: '┌', {down, right}: '┌',
+		{right, up}: '└', {up, right}: '└',
+	}, map[direction]rune{right: '▶', left: '◀', down: '▼', up: '▲'}
+}
@@ -0,0 +1,46 @@
+package diagram
+
+import (
+	"testing"
+
+	"github.com/Tagliapietra96/tui/tuitest"
+)
+
+func TestMain(m *testing.M) {
+	tuitest.Main(m)
+}
+
+func TestDiagramString(t *testing.T) {
+	tests := []struct {
+		diagram  *Diagram
+		expected string
+	}{
+		{
+			diagram:  New(),
+			expected: "",
+		},
+		{
+			diagram:  New().Box("a", "A", 0, 0).Box("b", "B", 8, 0).Connect("a", "b"),
+			expected: "╭───╮   ╭───╮\n│ A │──▶│ B │\n╰───╯   ╰───╯",
+		},
+		{
+			diagram:  New().Box("a", "A", 0, 0).Box("b", "B", 0, 5).Connect("b", "a").Connect("a", "missing"),
+			expected: "╭───╮\n│ A │\n╰───╯\n  ▲\n  │\n╭───╮\n│ B │\n╰───╯",
+		},
+		{
+			diagram:  New().Box("a", "A", 0, 0).Box("b", "B", 8, 3).Connect("a", "b"),
+			expected: "╭───╮\n│ A │─┐\n╰───╯ │\n      │ ╭───╮\n      └▶│ B │\n        ╰───╯",
+		},
+		{
+			diagram:  New().Box("a", "日本", 0, 0).Box("b", "B", 10, 0).Connect("a", "b"),
+			expected: "╭──────╮  ╭───╮\n│ 日本 │─▶│ B │\n╰──────╯  ╰───╯",
+		},
+	}
+
+	for _, test := range tests {
+		result := test.diagram.String()
+		if result != test.expected {
+			t.Errorf("Diagram.String() = %q; expected %q", result, test.expected)
+		}
+	}
+}
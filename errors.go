@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ErrorOption type is a function that configures the rendering of an error (see RenderError).
+type ErrorOption func(*errorReport)
+
+// errorReport holds the optional sections rendered by RenderError.
+type errorReport struct {
+	stack       string
+	suggestions []string
+}
+
+// WithStack returns an error option that adds a stack trace section to the rendered error.
+// It takes a stack trace as input (e.g. the result of debug.Stack()).
+func WithStack(stack []byte) ErrorOption {
+	return func(r *errorReport) {
+		r.stack = strings.TrimSpace(string(stack))
+	}
+}
+
+// WithSuggestions returns an error option that adds suggestion lines to the rendered error.
+func WithSuggestions(suggestions ...string) ErrorOption {
+	return func(r *errorReport) {
+		r.suggestions = append(r.suggestions, suggestions...)
+	}
+}
+
+// RenderError function renders an error and its chain of causes.
+// It takes an error and a list of error options as input and returns a styled string.
+// The error chain is unwrapped (including errors joined with errors.Join) and
+// rendered as an indented tree: the top message in bright bold text, the
+// causes in muted text. Each message is shown without the text of its
+// cause, so that wrapped messages (e.g. "read config: permission denied")
+// are not repeated.
+// If the error is nil, it returns an empty string.
+// Example:
+//
+//	RenderError(err, WithSuggestions("check the permissions of the file"))
+func RenderError(err error, options ...ErrorOption) string {
+	if err == nil {
+		return ""
+	}
+
+	report := &errorReport{}
+	for _, option := range options {
+		option(report)
+	}
+
+	palette := CurrentPalette()
+
+	muted := lipgloss.NewStyle().Foreground(palette.Muted)
+	lines := []string{
+		lipgloss.NewStyle().Foreground(palette.Error).Render(AdaptGlyph(GlyphError)) + " " +
+			lipgloss.NewStyle().Foreground(palette.Bright).Bold(true).Render(errorMessage(err)),
+	}
+	lines = append(lines, errorCauses(err, "  ", muted)...)
+
+	if report.stack != "" {
+		lines = append(lines, "", muted.Bold(true).Render("Stack trace:"))
+		for _, line := range strings.Split(report.stack, "\n") {
+			lines = append(lines, muted.Render("  "+line))
+		}
+	}
+
+	if len(report.suggestions) > 0 {
+		lines = append(lines, "")
+		for _, s := range report.suggestions {
+			lines = append(lines, lipgloss.NewStyle().Foreground(palette.Info).Render(AdaptGlyph(GlyphArrow)+" "+s))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// errorCauses function renders the causes of an error as tree branches.
+func errorCauses(err error, indent string, style lipgloss.Style) []string {
+	causes := unwrapError(err)
+	lines := make([]string, 0)
+	middle, last, line := treeGlyphs()
+	for i, cause := range causes {
+		branch, next := middle, line
+		if i == len(causes)-1 {
+			branch, next = last, "   "
+		}
+
+		lines = append(lines, style.Render(indent+branch+errorMessage(cause)))
+		lines = append(lines, errorCauses(cause, indent+next, style)...)
+	}
+
+	return lines
+}
+
+// unwrapError function returns the direct causes of an error.
+func unwrapError(err error) []error {
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		causes := make([]error, 0)
+		for _, cause := range e.Unwrap() {
+			if cause != nil {
+				causes = append(causes, cause)
+			}
+		}
+		return causes
+	default:
+		if cause := errors.Unwrap(err); cause != nil {
+			return []error{cause}
+		}
+		return nil
+	}
+}
+
+// errorMessage function returns the message of an error without the text of its cause.
+// Errors joined with errors.Join have no message of their own: a generic one is returned.
+func errorMessage(err error) string {
+	msg := err.Error()
+	causes := unwrapError(err)
+	if _, ok := err.(interface{ Unwrap() []error }); ok && len(causes) > 1 {
+		return "multiple errors"
+	}
+
+	if len(causes) == 1 {
+		if trimmed, ok := strings.CutSuffix(msg, causes[0].Error()); ok {
+			trimmed = strings.TrimRight(strings.TrimSpace(trimmed), ":")
+			if trimmed != "" {
+				return trimmed
+			}
+		}
+	}
+
+	return msg
+}
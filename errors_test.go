@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestRenderError(t *testing.T) {
+	base := errors.New("permission denied")
+	tests := []struct {
+		err      error
+		options  []ErrorOption
+		expected string
+	}{
+		{
+			err:      nil,
+			expected: "",
+		},
+		{
+			err:      base,
+			expected: "✘ permission denied",
+		},
+		{
+			err:      fmt.Errorf("load config: %w", fmt.Errorf("read file: %w", base)),
+			expected: "✘ load config\n  └─ read file\n     └─ permission denied",
+		},
+		{
+			err:      errors.Join(errors.New("a"), errors.New("b")),
+			expected: "✘ multiple errors\n  ├─ a\n  └─ b",
+		},
+		{
+			err:      base,
+			options:  []ErrorOption{WithSuggestions("retry with sudo"), WithStack([]byte("main.go:1\n"))},
+			expected: "✘ permission denied\n\nStack trace:\n  main.go:1\n\n→ retry with sudo",
+		},
+	}
+
+	for _, test := range tests {
+		result := RenderError(test.err, test.options...)
+		if result != test.expected {
+			t.Errorf("RenderError(%v) = %q; expected %q", test.err, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Fade function de-emphasizes an already rendered string.
+// It takes a rendered string and a level as input and returns the string with
+// its original colors and attributes replaced by muted ones.
+//   - 0 or less: the string is returned as is.
+//   - 1: the string is rendered with the light muted color.
+//   - 2 or more: the string is rendered with the muted color and the faint attribute.
+//
+// The layout is preserved: every line is restyled on its own, so the width
+// and height of the string do not change.
+// It is useful to dim inactive panes or the background behind an overlay.
+func Fade(str string, level int) string {
+	if level <= 0 {
+		return str
+	}
+
+	style := lipgloss.NewStyle().Foreground(CurrentPalette().LightMuted)
+	if level > 1 {
+		style = style.Foreground(CurrentPalette().Muted).Faint(true)
+	}
+
+	lines := strings.Split(ansi.Strip(str), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = style.Render(line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
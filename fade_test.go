@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+)
+
+func TestFade(t *testing.T) {
+	input := "\x1b[1;31mab\x1b[0m\n\ncd "
+	tests := []struct {
+		level    int
+		expected string
+	}{
+		{level: -1, expected: input},
+		{level: 0, expected: input},
+		{level: 1, expected: "ab\n\ncd "},
+		{level: 2, expected: "ab\n\ncd "},
+	}
+
+	for _, test := range tests {
+		result := Fade(input, test.level)
+		if result != test.expected {
+			t.Errorf("Fade(%q, %d) = %q; expected %q", input, test.level, result, test.expected)
+		}
+	}
+}
+
+func TestFadeColors(t *testing.T) {
+	defer lipgloss.SetColorProfile(lipgloss.ColorProfile())
+	lipgloss.SetColorProfile(termenv.TrueColor)
+
+	input := "\x1b[1;31mab\x1b[0m\n\ncd "
+	for level := 1; level <= 2; level++ {
+		result := Fade(input, level)
+		if ansi.Strip(result) != ansi.Strip(input) {
+			t.Errorf("Fade(%q, %d) changed the text to %q", input, level, ansi.Strip(result))
+		}
+		if lipgloss.Width(result) != lipgloss.Width(input) || lipgloss.Height(result) != lipgloss.Height(input) {
+			t.Errorf("Fade(%q, %d) = %dx%d; expected %dx%d", input, level,
+				lipgloss.Width(result), lipgloss.Height(result), lipgloss.Width(input), lipgloss.Height(input))
+		}
+		if strings.Contains(result, "\x1b[1;31m") {
+			t.Errorf("Fade(%q, %d) = %q; expected the original attributes to be replaced", input, level, result)
+		}
+		if faint := strings.HasPrefix(result, "\x1b[2;"); faint != (level > 1) {
+			t.Errorf("Fade(%q, %d) = %q; expected faint to be %v", input, level, result, level > 1)
+		}
+	}
+}
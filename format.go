@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locale type holds the conventions used to format numbers, dates and times.
+type Locale struct {
+	Name             string
+	DecimalSeparator string
+	GroupSeparator   string
+	DateLayout       string // time.Format layout used by FormatDate
+	TimeLayout       string // time.Format layout used by FormatTime
+}
+
+// built-in locales
+var (
+	LocaleEN  = Locale{Name: "en", DecimalSeparator: ".", GroupSeparator: ",", DateLayout: "Jan 2, 2006", TimeLayout: "3:04 PM"}
+	LocaleIT  = Locale{Name: "it", DecimalSeparator: ",", GroupSeparator: ".", DateLayout: "02/01/2006", TimeLayout: "15:04"}
+	LocaleDE  = Locale{Name: "de", DecimalSeparator: ",", GroupSeparator: ".", DateLayout: "02.01.2006", TimeLayout: "15:04"}
+	LocaleFR  = Locale{Name: "fr", DecimalSeparator: ",", GroupSeparator: " ", DateLayout: "02/01/2006", TimeLayout: "15:04"}
+	LocaleISO = Locale{Name: "iso", DecimalSeparator: ".", GroupSeparator: "", DateLayout: "2006-01-02", TimeLayout: "15:04:05"}
+)
+
+// locale state
+var (
+	localeMu sync.RWMutex
+	locale   = LocaleEN
+)
+
+// SetLocale function sets the locale used by the format functions of the package.
+func SetLocale(l Locale) {
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	locale = l
+}
+
+// CurrentLocale function returns the locale used by the format functions of the package.
+func CurrentLocale() Locale {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	return locale
+}
+
+// SetDateLayout function sets the date layout of the current locale (see time.Format).
+func SetDateLayout(layout string) {
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	locale.DateLayout = layout
+}
+
+// SetTimeLayout function sets the time layout of the current locale (see time.Format).
+func SetTimeLayout(layout string) {
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	locale.TimeLayout = layout
+}
+
+// FormatDate function formats the date of a time with the current locale.
+func FormatDate(t time.Time) string {
+	return t.Format(CurrentLocale().DateLayout)
+}
+
+// FormatTime function formats the time of the day of a time with the current locale.
+func FormatTime(t time.Time) string {
+	return t.Format(CurrentLocale().TimeLayout)
+}
+
+// FormatDateTime function formats the date and the time of the day of a time
+// with the current locale, separated by a space.
+func FormatDateTime(t time.Time) string {
+	l := CurrentLocale()
+	return t.Format(l.DateLayout + " " + l.TimeLayout)
+}
+
+// FormatNumber function formats a number with the current locale.
+// It takes a number and the number of decimals as input and returns the
+// number with the group and decimal separators of the locale.
+// If the number of decimals is less than 0, it is set to 0.
+// Example (with LocaleIT):
+//
+//	FormatNumber(1234567.891, 2) => "1.234.567,89"
+func FormatNumber(n float64, decimals int) string {
+	l := CurrentLocale()
+	s := strconv.FormatFloat(math.Abs(n), 'f', max(decimals, 0), 64)
+	integer, fraction, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	if n < 0 && strings.Trim(s, "0.") != "" {
+		b.WriteString("-")
+	}
+	for i, r := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(l.GroupSeparator)
+		}
+		b.WriteRune(r)
+	}
+	if fraction != "" {
+		b.WriteString(l.DecimalSeparator)
+		b.WriteString(fraction)
+	}
+
+	return b.String()
+}
+
+// FormatDuration function formats a duration in a short human readable form.
+// The precision depends on the magnitude of the duration:
+//
+//	350ms, 1.2s, 1m 23s, 2h 05m, 3d 4h
+//
+// The decimal separator of the current locale is used.
+// The duration is rounded to the precision of each unit before choosing it,
+// so it rolls over to the next unit (e.g. 59.96s is formatted as "1m 00s").
+// Negative durations are formatted as their absolute value.
+func FormatDuration(d time.Duration) string {
+	d = d.Abs()
+	if r := d.Round(time.Millisecond); r < time.Second {
+		return strconv.FormatInt(r.Milliseconds(), 10) + "ms"
+	}
+	if r := d.Round(100 * time.Millisecond); r < time.Minute {
+		return FormatNumber(r.Seconds(), 1) + "s"
+	}
+	if r := d.Round(time.Second); r < time.Hour {
+		return strconv.Itoa(int(r.Minutes())) + "m " + FormatIntWithPrefix(int(r.Seconds())%60, 2) + "s"
+	}
+	if r := d.Round(time.Minute); r < 24*time.Hour {
+		return strconv.Itoa(int(r.Hours())) + "h " + FormatIntWithPrefix(int(r.Minutes())%60, 2) + "m"
+	}
+
+	r := d.Round(time.Hour)
+	return strconv.Itoa(int(r.Hours())/24) + "d " + strconv.Itoa(int(r.Hours())%24) + "h"
+}
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatNumber(t *testing.T) {
+	defer SetLocale(LocaleEN)
+
+	tests := []struct {
+		locale   Locale
+		number   float64
+		decimals int
+		expected string
+	}{
+		{
+			locale:   LocaleEN,
+			number:   1234567.891,
+			decimals: 2,
+			expected: "1,234,567.89",
+		},
+		{
+			locale:   LocaleIT,
+			number:   1234567.891,
+			decimals: 2,
+			expected: "1.234.567,89",
+		},
+		{
+			locale:   LocaleEN,
+			number:   -999,
+			decimals: 0,
+			expected: "-999",
+		},
+		{
+			locale:   LocaleISO,
+			number:   12345.5,
+			decimals: -1,
+			expected: "12346",
+		},
+		{
+			locale:   LocaleEN,
+			number:   -0.001,
+			decimals: 1,
+			expected: "0.0",
+		},
+	}
+
+	for _, test := range tests {
+		SetLocale(test.locale)
+		result := FormatNumber(test.number, test.decimals)
+		if result != test.expected {
+			t.Errorf("FormatNumber(%v, %d) with locale %q = %q; expected %q", test.number, test.decimals, test.locale.Name, result, test.expected)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{
+			duration: 350 * time.Millisecond,
+			expected: "350ms",
+		},
+		{
+			duration: 1234 * time.Millisecond,
+			expected: "1.2s",
+		},
+		{
+			duration: 59960 * time.Millisecond,
+			expected: "1m 00s",
+		},
+		{
+			duration: 83 * time.Second,
+			expected: "1m 23s",
+		},
+		{
+			duration: 59*time.Minute + 59*time.Second + 600*time.Millisecond,
+			expected: "1h 00m",
+		},
+		{
+			duration: 2*time.Hour + 5*time.Minute,
+			expected: "2h 05m",
+		},
+		{
+			duration: 76 * time.Hour,
+			expected: "3d 4h",
+		},
+	}
+
+	for _, test := range tests {
+		result := FormatDuration(test.duration)
+		if result != test.expected {
+			t.Errorf("FormatDuration(%v) = %q; expected %q", test.duration, result, test.expected)
+		}
+	}
+}
+
+func TestFormatDateTime(t *testing.T) {
+	defer SetLocale(LocaleEN)
+
+	date := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+	SetLocale(LocaleIT)
+	SetTimeLayout("15.04")
+	if result := FormatDateTime(date); result != "05/03/2024 14.30" {
+		t.Errorf("FormatDateTime(%v) = %q; expected %q", date, result, "05/03/2024 14.30")
+	}
+}
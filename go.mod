@@ -4,16 +4,16 @@ go 1.22.1
 
 require (
 	github.com/charmbracelet/lipgloss v1.0.0
+	github.com/charmbracelet/x/ansi v0.4.5
 	github.com/charmbracelet/x/term v0.2.1
+	github.com/lucasb-eyer/go-colorful v1.2.0
+	github.com/muesli/termenv v0.15.2
+	github.com/rivo/uniseg v0.4.7
 )
 
 require (
 	github.com/aymanbagabas/go-osc52/v2 v2.0.1 // indirect
-	github.com/charmbracelet/x/ansi v0.4.5 // indirect
-	github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mattn/go-runewidth v0.0.15 // indirect
-	github.com/muesli/termenv v0.15.2 // indirect
-	github.com/rivo/uniseg v0.4.7 // indirect
 	golang.org/x/sys v0.27.0 // indirect
 )
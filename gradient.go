@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/rivo/uniseg"
+)
+
+// GradientText function colors a string with a horizontal gradient.
+// It takes a string and the colors at the start and at the end of each line
+// as input, and colors every character with a color interpolated between them.
+// The existing styles of the string are stripped. The colors are converted to
+// the color profile of the terminal, so the gradient degrades to the nearest
+// 256 or 16 colors and disappears without color support.
+// If one of the colors cannot be parsed, the string is returned without styles.
+func GradientText(s string, from, to lipgloss.Color) string {
+	f, ok := colorToRGB(from)
+	if !ok {
+		return ansi.Strip(s)
+	}
+	t, ok := colorToRGB(to)
+	if !ok {
+		return ansi.Strip(s)
+	}
+
+	return gradient(s, func(p float64) colorful.Color {
+		return f.BlendLab(t, p).Clamped()
+	})
+}
+
+// RainbowText function colors a string with a rainbow gradient (see GradientText).
+func RainbowText(s string) string {
+	return gradient(s, func(p float64) colorful.Color {
+		return colorful.Hsv(p*300, 0.8, 1)
+	})
+}
+
+// gradient function colors every character of each line of a string with
+// the color returned by the function for its position (from 0 to 1).
+// Only the foreground color is set, so the gradient can be combined with
+// other styles (e.g. bold).
+func gradient(s string, colorAt func(p float64) colorful.Color) string {
+	profile := lipgloss.ColorProfile()
+	lines := strings.Split(ansi.Strip(s), "\n")
+	for i, line := range lines {
+		n := uniseg.GraphemeClusterCount(line)
+		if n == 0 {
+			continue
+		}
+
+		var b strings.Builder
+		colored := false
+		g := uniseg.NewGraphemes(line)
+		for j := 0; g.Next(); j++ {
+			p := 0.0
+			if n > 1 {
+				p = float64(j) / float64(n-1)
+			}
+			if seq := profile.Color(colorAt(p).Hex()).Sequence(false); seq != "" && g.Str() != " " {
+				b.WriteString("\x1b[" + seq + "m")
+				colored = true
+			}
+			b.WriteString(g.Str())
+		}
+		if colored {
+			// reset the foreground color only
+			b.WriteString("\x1b[39m")
+		}
+		lines[i] = b.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,32 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestGradientText(t *testing.T) {
+	tests := []struct {
+		text     string
+		from     lipgloss.Color
+		to       lipgloss.Color
+		profile  termenv.Profile
+		expected string
+	}{
+		{text: "ab", from: "#ff0000", to: "#0000ff", profile: termenv.Ascii, expected: "ab"},
+		{text: "ab", from: "#ff0000", to: "#0000ff", profile: termenv.TrueColor, expected: "\x1b[38;2;255;0;0ma\x1b[38;2;0;0;255mb\x1b[39m"},
+		{text: "a b\n", from: "#ff0000", to: "#ff0000", profile: termenv.TrueColor, expected: "\x1b[38;2;255;0;0ma \x1b[38;2;255;0;0mb\x1b[39m\n"},
+		{text: "\x1b[1mab\x1b[0m", from: "bad", to: "#0000ff", profile: termenv.TrueColor, expected: "ab"},
+	}
+
+	defer lipgloss.SetColorProfile(lipgloss.ColorProfile())
+	for _, test := range tests {
+		lipgloss.SetColorProfile(test.profile)
+		result := GradientText(test.text, test.from, test.to)
+		if result != test.expected {
+			t.Errorf("GradientText(%q, %q, %q) = %q; expected %q", test.text, test.from, test.to, result, test.expected)
+		}
+	}
+}
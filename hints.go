@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// hints tracks whether the key hints are rendered.
+var (
+	hintsMu sync.RWMutex
+	hints   = true
+)
+
+// SetHints function toggles the key hints globally.
+// When disabled, Hint and Hints return empty strings, so components can
+// always render their hints and let the application decide whether to show them.
+// The hints are enabled by default.
+func SetHints(enabled bool) {
+	hintsMu.Lock()
+	defer hintsMu.Unlock()
+	hints = enabled
+}
+
+// hintsEnabled function reports whether the key hints are rendered.
+func hintsEnabled() bool {
+	hintsMu.RLock()
+	defer hintsMu.RUnlock()
+	return hints
+}
+
+// Hint function returns a keyboard shortcut hint (e.g. "[enter] select").
+// It takes the description of the action and the key as input and returns
+// the bracketed key in the light muted color followed by the description in the muted color.
+// If the hints are disabled (see SetHints), it returns an empty string.
+func Hint(text, key string) string {
+	if !hintsEnabled() {
+		return ""
+	}
+
+	return lipgloss.NewStyle().Foreground(CurrentPalette().LightMuted).Inline(true).Render("["+key+"]") + " " +
+		lipgloss.NewStyle().Foreground(CurrentPalette().Muted).Inline(true).Render(text)
+}
+
+// Hints function joins a list of hints on a single line, separated by dots
+// (e.g. "[enter] select · [/] filter · [q] quit"). Empty hints are skipped.
+// If the hints are disabled (see SetHints), it returns an empty string.
+func Hints(hs ...string) string {
+	if !hintsEnabled() {
+		return ""
+	}
+
+	parts := make([]string, 0, len(hs))
+	for _, h := range hs {
+		if h != "" {
+			parts = append(parts, h)
+		}
+	}
+
+	sep := lipgloss.NewStyle().Foreground(CurrentPalette().Muted).Inline(true).Render(" " + AdaptGlyph(GlyphDot) + " ")
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,19 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestHints(t *testing.T) {
+	defer SetHints(true)
+
+	result := Hints(Hint("select", "enter"), "", Hint("quit", "q"))
+	if result != "[enter] select · [q] quit" {
+		t.Errorf("Hints = %q; expected %q", result, "[enter] select · [q] quit")
+	}
+
+	SetHints(false)
+	if result := Hints(Hint("select", "enter")); result != "" {
+		t.Errorf("Hints with hints disabled = %q; expected %q", result, "")
+	}
+}
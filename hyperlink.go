@@ -0,0 +1,41 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// SetHyperlinks function forces the hyperlink support of the current
+// profile on or off, instead of detecting it from the environment (see Hyperlink and SetProfile).
+func SetHyperlinks(enabled bool) {
+	profileMu.Lock()
+	defer profileMu.Unlock()
+	profile.Hyperlinks = enabled
+}
+
+// Hyperlink function returns a clickable link.
+// It takes the text of the link and the url as input.
+// If the terminal supports hyperlinks (see CurrentProfile), the text is wrapped in the OSC 8
+// escape sequences so that it opens the url when clicked. Otherwise the url
+// is added after the text, e.g. "docs (https://example.com)".
+// The text is styled with the link color and underlined.
+// If the text is empty or equal to the url, only the url is rendered.
+// The control characters of the url are removed, so that it cannot end the
+// escape sequence early.
+func Hyperlink(text, url string) string {
+	url = sanitizeOSC(url)
+	style := lipgloss.NewStyle().Foreground(CurrentPalette().Link).Underline(true).Inline(true)
+	if text == "" {
+		text = url
+	}
+
+	if CurrentProfile().Hyperlinks {
+		return ansi.SetHyperlink(url) + style.Render(text) + ansi.ResetHyperlink()
+	}
+
+	if text == url {
+		return style.Render(url)
+	}
+
+	return style.Render(text) + " " + lipgloss.NewStyle().Foreground(CurrentPalette().Muted).Inline(true).Render("("+url+")")
+}
@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestHyperlink(t *testing.T) {
+	defer SetHyperlinks(false)
+
+	tests := []struct {
+		supported bool
+		text      string
+		url       string
+		expected  string
+	}{
+		{
+			supported: false,
+			text:      "docs",
+			url:       "https://example.com",
+			expected:  "docs (https://example.com)",
+		},
+		{
+			supported: false,
+			text:      "",
+			url:       "https://example.com",
+			expected:  "https://example.com",
+		},
+		{
+			supported: true,
+			text:      "docs",
+			url:       "https://example.com",
+			expected:  "\x1b]8;;https://example.com\x07docs\x1b]8;;\x07",
+		},
+		{
+			supported: true,
+			text:      "docs",
+			url:       "https://example.com\x07\x1b]0;pwned\u009c",
+			expected:  "\x1b]8;;https://example.com]0;pwned\x07docs\x1b]8;;\x07",
+		},
+		{
+			supported: false,
+			text:      "docs",
+			url:       "https://example.com\x1b[2J",
+			expected:  "docs (https://example.com[2J)",
+		},
+	}
+
+	for _, test := range tests {
+		SetHyperlinks(test.supported)
+		result := Hyperlink(test.text, test.url)
+		if result != test.expected {
+			t.Errorf("Hyperlink(%q, %q) with support %v = %q; expected %q", test.text, test.url, test.supported, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/Tagliapietra96/tui/cursor"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// InPlace type prints frames over the previous one, so that a loop can update
+// a status block without switching to the alternate screen.
+// Each frame is printed where the previous one started, erasing it; the first
+// frame is printed at the current position of the cursor.
+// An InPlace is safe for concurrent use.
+type InPlace struct {
+	mu     sync.Mutex
+	w      io.Writer
+	height int
+}
+
+// NewInPlace function returns a new in-place printer writing to the given writer.
+// If the writer is nil, the output is used (see SetOutput).
+func NewInPlace(w io.Writer) *InPlace {
+	if w == nil {
+		w = Output()
+	}
+	return &InPlace{w: w}
+}
+
+// Print method replaces the previous frame with a new one.
+// It takes a list of strings as input and writes each of them on its own line,
+// like Print. In plain mode (see SetPlain) the cursor cannot be moved, so the
+// frames are printed one after the other.
+func (p *InPlace) Print(strs ...string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := strings.Join(strs, "\n")
+	if IsPlain() {
+		_, err := io.WriteString(p.w, Plain(s)+"\n")
+		return err
+	}
+
+	_, err := io.WriteString(p.w, p.erase()+s+"\n")
+	p.height = frameHeight(s)
+	return err
+}
+
+// Clear method erases the previous frame and moves the cursor where it started.
+func (p *InPlace) Clear() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if IsPlain() || p.height == 0 {
+		return nil
+	}
+
+	_, err := io.WriteString(p.w, p.erase())
+	p.height = 0
+	return err
+}
+
+// Done method keeps the previous frame on the screen: the next frame is
+// printed below it instead of replacing it.
+func (p *InPlace) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.height = 0
+}
+
+// erase method returns the sequence that moves the cursor to the start of
+// the previous frame and erases the screen below it.
+// It must be called with the lock held.
+func (p *InPlace) erase() string {
+	if p.height == 0 {
+		return ""
+	}
+	return "\r" + cursor.Up(p.height) + ansi.EraseScreenBelow
+}
+
+// frameHeight function returns the number of terminal rows taken by a string,
+// including the lines wrapped by the terminal because they are wider than it.
+// If the width of the terminal cannot be detected (the fallback width is not
+// used) or is overridden with 0, every line takes one row.
+func frameHeight(s string) int {
+	lines := strings.Split(s, "\n")
+	width, _, ok := terminalSize()
+	if !ok || width <= 0 {
+		return len(lines)
+	}
+
+	height := 0
+	for _, line := range lines {
+		height += max((lipgloss.Width(line)+width-1)/width, 1)
+	}
+	return height
+}
@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestInPlace(t *testing.T) {
+	// toggle the plain mode without changing the profile used by the other tests
+	defer func(enabled bool) { plain = enabled }(plain)
+	defer SetTerminalSize(0, 0)
+	plain = false
+	SetTerminalSize(10, 5)
+
+	var b bytes.Buffer
+	p := NewInPlace(&b)
+	p.Print("one", "two")
+	p.Print("a very long line")
+	p.Done()
+	p.Print("end")
+
+	expected := "one\ntwo\n" + "\r\x1b[2A\x1b[0J" + "a very long line\n" + "end\n"
+	if b.String() != expected {
+		t.Errorf("InPlace output = %q; expected %q", b.String(), expected)
+	}
+
+	b.Reset()
+	p.Clear()
+	if b.String() != "\r\x1b[A\x1b[0J" {
+		t.Errorf("InPlace.Clear() output = %q; expected %q", b.String(), "\r\x1b[A\x1b[0J")
+	}
+}
+
+func TestFrameHeight(t *testing.T) {
+	defer SetTerminalSize(0, 0)
+
+	tests := []struct {
+		width    int
+		text     string
+		expected int
+	}{
+		{width: 10, text: "one", expected: 1},
+		{width: 10, text: "one\n\ntwo", expected: 3},
+		{width: 10, text: "a very long line", expected: 2},
+		{width: 5, text: "0123456789\nab", expected: 3},
+		{width: 0, text: "a very long line", expected: 1},
+		{width: 0, text: "a very long line\n\nend", expected: 3},
+	}
+
+	for _, test := range tests {
+		SetTerminalSize(test.width, 5)
+		result := frameHeight(test.text)
+		if result != test.expected {
+			t.Errorf("frameHeight(%q) with width %d = %d; expected %d", test.text, test.width, result, test.expected)
+		}
+	}
+
+	// the fallback width does not apply when the size cannot be detected
+	if _, _, ok := detectTerminalSize(); ok {
+		t.Skip("stdout is a terminal")
+	}
+	defer SetFallbackWidth(80)
+	SetTerminalSize(0, 0)
+	SetFallbackWidth(5)
+	if result := frameHeight("a very long line"); result != 1 {
+		t.Errorf("frameHeight(%q) with an unknown width = %d; expected %d", "a very long line", result, 1)
+	}
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LegendSwatch is the symbol rendered with the color of a legend item.
+var LegendSwatch = "■"
+
+// LegendItem type is an entry of a legend: a label and the color it explains.
+type LegendItem struct {
+	Label string
+	Color lipgloss.TerminalColor
+}
+
+// Legend function renders a color key: a swatch of each color followed by its label.
+// It takes the maximum width of the legend and a list of legend items as input.
+// The items are separated by a gap of 2 (adjusted to the density of the
+// theme, see Spacing) and wrapped on several lines if they do not fit the width.
+// If the width is less than or equal to 0, the width of the terminal is used.
+func Legend(width int, items ...LegendItem) string {
+	if width <= 0 {
+		width, _ = getTerminalSize()
+	}
+
+	rendered := make([]string, len(items))
+	for i, item := range items {
+		rendered[i] = lipgloss.NewStyle().Foreground(item.Color).Render(AdaptGlyph(LegendSwatch)) + " " +
+			lipgloss.NewStyle().Foreground(CurrentPalette().Bright).Render(item.Label)
+	}
+
+	return flow(width, Spacing(2), rendered...)
+}
+
+// PaletteLegend function renders a legend of the colors of the current theme
+// (see Legend), labeled with their names (e.g. "Accent", "Error").
+// It takes the maximum width of the legend as input.
+func PaletteLegend(width int) string {
+	items := make([]LegendItem, 0, 9)
+	p := CurrentPalette()
+	for _, c := range p.palette() {
+		items = append(items, LegendItem{Label: c.name, Color: *c.color})
+	}
+
+	return Legend(width, items...)
+}
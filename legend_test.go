@@ -0,0 +1,38 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestLegend(t *testing.T) {
+	tests := []struct {
+		width    int
+		items    []LegendItem
+		expected string
+	}{
+		{
+			width:    40,
+			items:    []LegendItem{},
+			expected: "",
+		},
+		{
+			width:    40,
+			items:    []LegendItem{{"cpu", lipgloss.Color("1")}, {"memory", lipgloss.Color("2")}},
+			expected: "■ cpu  ■ memory",
+		},
+		{
+			width:    12,
+			items:    []LegendItem{{"cpu", lipgloss.Color("1")}, {"memory", lipgloss.Color("2")}, {"io", lipgloss.Color("3")}},
+			expected: "■ cpu\n■ memory\n■ io",
+		},
+	}
+
+	for _, test := range tests {
+		result := Legend(test.width, test.items...)
+		if result != test.expected {
+			t.Errorf("Legend(%d, %v) = %q; expected %q", test.width, test.items, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package logview
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/opts"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Level type represents the level of a log line, which determines its color.
+type Level int
+
+// levels
+const (
+	LevelPlain Level = iota
+	LevelInfo
+	LevelSuccess
+	LevelWarning
+	LevelError
+)
+
+// options method returns the style options used to render a line of the level.
+func (l Level) options() []tui.StyleOption {
+	switch l {
+	case LevelInfo:
+		return []tui.StyleOption{opts.Info}
+	case LevelSuccess:
+		return []tui.StyleOption{opts.Success}
+	case LevelWarning:
+		return []tui.StyleOption{opts.Warning}
+	case LevelError:
+		return []tui.StyleOption{opts.Error}
+	default:
+		return []tui.StyleOption{opts.Bright}
+	}
+}
+
+// line type is a single line of a log view.
+type line struct {
+	level Level
+	text  string
+}
+
+// LogView type represents an append-only view of log lines.
+// The lines are kept in a ring buffer: once the maximum number of lines is
+// reached, the oldest lines are dropped.
+// A LogView is safe for concurrent use, so background goroutines can append
+// lines while the view is rendered; it also implements io.Writer, so it can
+// collect the output of a command.
+// A LogView is rendered with the String method.
+type LogView struct {
+	mu      sync.Mutex
+	lines   []line
+	head    int
+	size    int
+	pending string
+	height  int
+	width   int
+	offset  int
+	follow  bool
+	search  string
+}
+
+// New function returns a new log view holding at most the given number of lines.
+// If the maximum is less than 1, it is set to 1.
+// By default the view follows the tail and shows all the lines.
+func New(maximum int) *LogView {
+	return &LogView{
+		lines:  make([]line, max(maximum, 1)),
+		follow: true,
+	}
+}
+
+// Add method appends a text to the view with the given level.
+// Multi-line texts are split into several lines with the same level.
+// The styles of the text are stripped, so that the lines are colored
+// according to their level.
+func (v *LogView) Add(level Level, text string) *LogView {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, l := range strings.Split(text, "\n") {
+		v.push(level, l)
+	}
+	return v
+}
+
+// Write method appends the bytes to the view as plain lines.
+// Incomplete lines are kept until the next newline is written.
+// It implements io.Writer and never returns an error.
+func (v *LogView) Write(p []byte) (int, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	lines := strings.Split(v.pending+string(p), "\n")
+	v.pending = lines[len(lines)-1]
+	for _, l := range lines[:len(lines)-1] {
+		v.push(LevelPlain, strings.TrimSuffix(l, "\r"))
+	}
+	return len(p), nil
+}
+
+// push method appends a single line to the ring buffer.
+// It must be called with the lock held.
+func (v *LogView) push(level Level, text string) {
+	v.lines[(v.head+v.size)%len(v.lines)] = line{level: level, text: ansi.Strip(text)}
+	if v.size < len(v.lines) {
+		v.size++
+	} else {
+		v.head = (v.head + 1) % len(v.lines)
+		v.offset = max(v.offset-1, 0)
+	}
+}
+
+// Len method returns the number of lines held by the view.
+func (v *LogView) Len() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.size
+}
+
+// Clear method removes all the lines from the view.
+func (v *LogView) Clear() *LogView {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.head, v.size, v.offset, v.pending = 0, 0, 0, ""
+	return v
+}
+
+// Height method sets the number of visible lines.
+// If the height is less than or equal to 0, all the lines are visible.
+// When not all the lines are visible, a scrollbar is rendered on the right.
+func (v *LogView) Height(height int) *LogView {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.height = max(height, 0)
+	return v
+}
+
+// Width method sets the maximum width of the lines: longer lines are truncated.
+// If the width is less than or equal to 0, the lines are not truncated.
+func (v *LogView) Width(width int) *LogView {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.width = max(width, 0)
+	return v
+}
+
+// Follow method toggles the follow-tail mode: when enabled, the view always
+// shows the last lines, scrolling as new lines are added.
+func (v *LogView) Follow(enabled bool) *LogView {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.follow = enabled
+	return v
+}
+
+// Scroll method moves the visible window by the given number of lines
+// (negative values scroll up). Scrolling disables the follow-tail mode,
+// unless the window reaches the last line.
+func (v *LogView) Scroll(delta int) *LogView {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.height == 0 {
+		return v
+	}
+
+	last := max(v.size-v.height, 0)
+	if v.follow {
+		v.offset = last
+	}
+	v.offset = min(max(v.offset+delta, 0), last)
+	v.follow = v.offset == last
+	return v
+}
+
+// Search method sets the term highlighted in the lines.
+// The search is case-sensitive; an empty term removes the highlighting.
+func (v *LogView) Search(term string) *LogView {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.search = term
+	return v
+}
+
+// Matches method returns the indexes of the lines containing the search term,
+// in ascending order. The index 0 is the oldest line held by the view.
+func (v *LogView) Matches() []int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	matches := make([]int, 0)
+	if v.search == "" {
+		return matches
+	}
+	for i := 0; i < v.size; i++ {
+		if strings.Contains(v.at(i).text, v.search) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// at method returns the line with the given index, 0 being the oldest line.
+// It must be called with the lock held.
+func (v *LogView) at(i int) line {
+	return v.lines[(v.head+i)%len(v.lines)]
+}
+
+// String method renders the visible lines of the view.
+func (v *LogView) String() string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	start, end := 0, v.size
+	if v.height > 0 && v.size > v.height {
+		start = min(v.offset, v.size-v.height)
+		if v.follow {
+			start = v.size - v.height
+		}
+		end = start + v.height
+	}
+
+	rendered := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		rendered = append(rendered, v.render(v.at(i)))
+	}
+
+	view := strings.Join(rendered, "\n")
+	if end-start < v.size {
+		view = tui.WithScrollbar(view, v.size, start)
+	}
+	return view
+}
+
+// render method renders a single line, highlighting the search term.
+// It must be called with the lock held.
+func (v *LogView) render(l line) string {
+	options := append(l.level.options(), opts.Inline)
+	text := tui.Render(l.text, options...)
+
+	// the highlight is computed on the plain text and the styled line is
+	// truncated afterwards, so the cut never splits an escape sequence
+	if v.search != "" && strings.Contains(l.text, v.search) {
+		parts := strings.Split(l.text, v.search)
+		highlight := tui.Render(v.search, opts.Accent, opts.Bold, opts.Inline, reverse)
+		for i, p := range parts {
+			parts[i] = tui.Render(p, options...)
+		}
+		text = strings.Join(parts, highlight)
+	}
+
+	if v.width > 0 {
+		text = tui.TruncateString(text, v.width)
+	}
+	return text
+}
+
+// reverse is a style option that swaps the foreground and background colors.
+func reverse(s lipgloss.Style) lipgloss.Style {
+	return s.Reverse(true)
+}
@@ -0,0 +1,88 @@
+package logview
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Tagliapietra96/tui/tuitest"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+)
+
+func TestMain(m *testing.M) {
+	tuitest.Main(m)
+}
+
+func TestLogViewString(t *testing.T) {
+	tests := []struct {
+		view     *LogView
+		expected string
+	}{
+		{
+			view:     New(10),
+			expected: "",
+		},
+		{
+			view:     New(2).Add(LevelInfo, "one").Add(LevelError, "two\nthree"),
+			expected: "two\nthree",
+		},
+		{
+			view:     New(10).Add(LevelPlain, "a\nb\nc\nd").Height(2),
+			expected: "c │\nd ┃",
+		},
+		{
+			view:     New(10).Add(LevelPlain, "a\nb\nc\nd").Height(2).Scroll(-2),
+			expected: "a ┃\nb │",
+		},
+		{
+			view:     New(10).Add(LevelWarning, "a very long line").Width(8),
+			expected: "a ver...",
+		},
+	}
+
+	for _, test := range tests {
+		result := test.view.String()
+		if result != test.expected {
+			t.Errorf("LogView.String() = %q; expected %q", result, test.expected)
+		}
+	}
+}
+
+func TestLogViewWrite(t *testing.T) {
+	v := New(10)
+	fmt.Fprint(v, "first\nsec")
+	fmt.Fprint(v, "ond\r\nthird")
+
+	if result := v.String(); result != "first\nsecond" {
+		t.Errorf("LogView.String() = %q; expected %q", result, "first\nsecond")
+	}
+	if v.Search("o"); fmt.Sprint(v.Matches()) != "[1]" {
+		t.Errorf("LogView.Matches() = %v; expected %v", v.Matches(), []int{1})
+	}
+}
+
+func TestLogViewSearchColors(t *testing.T) {
+	defer lipgloss.SetColorProfile(lipgloss.ColorProfile())
+	lipgloss.SetColorProfile(termenv.ANSI256)
+
+	// every escape character must start a complete SGR sequence
+	broken := regexp.MustCompile(`\x1b(?:[^\[]|\[[0-9;]*(?:[^0-9;m]|$))`)
+	tests := []struct {
+		text     string
+		search   string
+		expected string
+	}{
+		{text: "line 12345 done", search: "5", expected: "line ..."},
+		{text: "line 12345 done", search: "line", expected: "line ..."},
+		{text: "ab5", search: "5", expected: "ab5"},
+	}
+
+	for _, test := range tests {
+		result := New(10).Add(LevelInfo, test.text).Width(8).Search(test.search).String()
+		if ansi.Strip(result) != test.expected || broken.MatchString(result) {
+			t.Errorf("LogView.String() with search %q = %q; expected %q with well-formed escape sequences", test.search, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package tui_test
+
+import (
+	"testing"
+
+	"github.com/Tagliapietra96/tui/tuitest"
+)
+
+func TestMain(m *testing.M) {
+	tuitest.Main(m)
+}
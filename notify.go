@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// notification protocols
+const (
+	notifyBell   = iota // only the terminal bell
+	notifyOSC9          // OSC 9 (iTerm2, WezTerm, kitty, ghostty)
+	notifyOSC777        // OSC 777 (urxvt, foot)
+)
+
+// Notify function alerts the user, e.g. when a long-running task completes or fails.
+// It takes the title and the body of the notification as input.
+// It rings the terminal bell and, if the terminal supports it, shows a desktop
+// notification through the OSC 9 or OSC 777 escape sequences.
+// The sequences are written to the output (see SetOutput); in plain mode
+// (see SetPlain) nothing is written.
+func Notify(title, body string) error {
+	if IsPlain() {
+		return nil
+	}
+
+	_, err := io.WriteString(Output(), notification(detectNotify(), title, body))
+	return err
+}
+
+// notification function returns the escape sequences of a notification for the given protocol.
+// The control characters of the title and the body are removed, so that they
+// cannot terminate the sequence early.
+func notification(protocol int, title, body string) string {
+	title, body = sanitizeOSC(title), sanitizeOSC(body)
+
+	switch protocol {
+	case notifyOSC9:
+		message := body
+		if title != "" && body != "" {
+			message = title + ": " + body
+		} else if title != "" {
+			message = title
+		}
+		return "\a" + ansi.Notify(message)
+	case notifyOSC777:
+		// the title is delimited by a semicolon, so it cannot contain one
+		return "\a\x1b]777;notify;" + strings.ReplaceAll(title, ";", ",") + ";" + body + "\a"
+	default:
+		return "\a"
+	}
+}
+
+// sanitizeOSC function removes the control characters from a string embedded in an OSC sequence.
+// Both the C0 and the C1 control characters are removed, since some terminals
+// end the sequence on the C1 string terminator.
+func sanitizeOSC(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r < 0xa0) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// detectNotify function returns the notification protocol supported by the terminal.
+func detectNotify() int {
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "ghostty":
+		return notifyOSC9
+	}
+	if os.Getenv("KITTY_WINDOW_ID") != "" {
+		return notifyOSC9
+	}
+
+	t := os.Getenv("TERM")
+	if strings.HasPrefix(t, "rxvt") || strings.Contains(t, "foot") {
+		return notifyOSC777
+	}
+	return notifyBell
+}
@@ -0,0 +1,24 @@
+package tui
+
+import "testing"
+
+func TestNotification(t *testing.T) {
+	tests := []struct {
+		protocol int
+		title    string
+		body     string
+		expected string
+	}{
+		{notifyBell, "Build", "done", "\a"},
+		{notifyOSC9, "Build", "done", "\a\x1b]9;Build: done\a"},
+		{notifyOSC9, "Build", "", "\a\x1b]9;Build\a"},
+		{notifyOSC777, "Build;1", "done\x07", "\a\x1b]777;notify;Build,1;done\a"},
+	}
+
+	for _, test := range tests {
+		result := notification(test.protocol, test.title, test.body)
+		if result != test.expected {
+			t.Errorf("notification(%d, %q, %q) = %q; expected %q", test.protocol, test.title, test.body, result, test.expected)
+		}
+	}
+}
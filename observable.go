@@ -0,0 +1,185 @@
+package tui
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Value type is an observable value.
+// Subscribers are notified every time the value is set, which allows the
+// displayed text of a component to follow a changing value (a counter, a
+// status) without manual plumbing.
+// A Value is safe for concurrent use.
+type Value[T any] struct {
+	mu          sync.RWMutex
+	value       T
+	subscribers map[int]func(T)
+	next        int
+	version     uint64
+}
+
+// NewValue function returns a new observable value holding the provided value.
+func NewValue[T any](value T) *Value[T] {
+	return &Value[T]{
+		value:       value,
+		subscribers: make(map[int]func(T)),
+	}
+}
+
+// Get method returns the current value.
+func (v *Value[T]) Get() T {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.value
+}
+
+// Set method sets the value and notifies the subscribers.
+func (v *Value[T]) Set(value T) {
+	v.mu.Lock()
+	v.value = value
+	v.version++
+	v.mu.Unlock()
+	v.notify(value)
+}
+
+// Update method sets the value to the result of the provided function,
+// called with the current value, and notifies the subscribers.
+func (v *Value[T]) Update(f func(T) T) {
+	v.mu.Lock()
+	v.value = f(v.value)
+	v.version++
+	value := v.value
+	v.mu.Unlock()
+	v.notify(value)
+}
+
+// Version method returns a counter incremented every time the value is set.
+// It implements the Dependency interface.
+func (v *Value[T]) Version() uint64 {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.version
+}
+
+// Subscribe method registers a function called with the new value every time
+// the value is set. It returns a function that removes the subscription.
+func (v *Value[T]) Subscribe(f func(T)) func() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	id := v.next
+	v.next++
+	v.subscribers[id] = f
+
+	return func() {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		delete(v.subscribers, id)
+	}
+}
+
+// notify method calls the subscribers with the provided value.
+// The subscribers are called without holding the lock, so they can read or
+// set the value themselves.
+func (v *Value[T]) notify(value T) {
+	v.mu.RLock()
+	subscribers := make([]func(T), 0, len(v.subscribers))
+	for _, f := range v.subscribers {
+		subscribers = append(subscribers, f)
+	}
+	v.mu.RUnlock()
+
+	for _, f := range subscribers {
+		f(value)
+	}
+}
+
+// Binding type is a text bound to an observable value.
+// Its String method renders the current value every time it is called.
+type Binding[T any] struct {
+	value   *Value[T]
+	format  func(T) string
+	options []StyleOption
+}
+
+// Bind function returns a text bound to an observable value.
+// It takes an observable value, a format function and a list of style
+// options as input. If the format function is nil, the value is formatted
+// with fmt.Sprint.
+// Example:
+//
+//	count := NewValue(0)
+//	label := Bind(count, func(n int) string { return fmt.Sprintf("%d items", n) }, opts.Muted)
+//	count.Set(3)
+//	label.String() // "3 items", styled with the muted color
+func Bind[T any](value *Value[T], format func(T) string, options ...StyleOption) *Binding[T] {
+	if format == nil {
+		format = func(v T) string {
+			return fmt.Sprint(v)
+		}
+	}
+
+	return &Binding[T]{value: value, format: format, options: options}
+}
+
+// String method renders the current value of the binding.
+func (b *Binding[T]) String() string {
+	return Render(b.format(b.value.Get()), b.options...)
+}
+
+// Dependency interface is implemented by the values a Computed text depends on.
+// Version must change every time the value changes.
+type Dependency interface {
+	Version() uint64
+}
+
+// Computed type is a text derived from a list of dependencies.
+// The render function is called again only when one of the dependencies
+// changed since the last render; otherwise the cached text is returned.
+// A Computed is safe for concurrent use.
+type Computed struct {
+	mu       sync.Mutex
+	render   func() string
+	deps     []Dependency
+	versions []uint64
+	cache    string
+	valid    bool
+}
+
+// NewComputed function returns a text derived from a list of dependencies.
+// It takes a render function and the dependencies it reads as input.
+// Without dependencies the render function is called on every render.
+// Example:
+//
+//	user, count := NewValue("ada"), NewValue(3)
+//	header := NewComputed(func() string {
+//		return Render(fmt.Sprintf("%s (%d)", user.Get(), count.Get()), opts.Bold)
+//	}, user, count)
+func NewComputed(render func() string, deps ...Dependency) *Computed {
+	return &Computed{
+		render:   render,
+		deps:     deps,
+		versions: make([]uint64, len(deps)),
+	}
+}
+
+// String method returns the derived text, rendering it again only if one of
+// the dependencies changed.
+func (c *Computed) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	changed := !c.valid || len(c.deps) == 0
+	for i, d := range c.deps {
+		if v := d.Version(); v != c.versions[i] {
+			c.versions[i] = v
+			changed = true
+		}
+	}
+
+	if changed {
+		c.cache = c.render()
+		c.valid = true
+	}
+
+	return c.cache
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	v := NewValue(1)
+	notified := make([]int, 0)
+	unsubscribe := v.Subscribe(func(n int) {
+		notified = append(notified, n)
+	})
+
+	v.Set(2)
+	v.Update(func(n int) int { return n * 10 })
+	unsubscribe()
+	v.Set(3)
+
+	if v.Get() != 3 {
+		t.Errorf("Value.Get() = %d; expected %d", v.Get(), 3)
+	}
+	if len(notified) != 2 || notified[0] != 2 || notified[1] != 20 {
+		t.Errorf("subscriber notified with %v; expected %v", notified, []int{2, 20})
+	}
+}
+
+func TestBind(t *testing.T) {
+	v := NewValue("idle")
+	b := Bind(v, nil)
+	if b.String() != "idle" {
+		t.Errorf("Binding.String() = %q; expected %q", b.String(), "idle")
+	}
+
+	v.Set("running")
+	if b.String() != "running" {
+		t.Errorf("Binding.String() = %q; expected %q", b.String(), "running")
+	}
+}
+
+func TestComputed(t *testing.T) {
+	v := NewValue(1)
+	calls := 0
+	c := NewComputed(func() string {
+		calls++
+		return Render("value")
+	}, v)
+
+	_ = c.String()
+	_ = c.String()
+	if calls != 1 {
+		t.Errorf("render function called %d times without changes; expected %d", calls, 1)
+	}
+
+	v.Set(2)
+	_ = c.String()
+	if calls != 2 {
+		t.Errorf("render function called %d times after a change; expected %d", calls, 2)
+	}
+}
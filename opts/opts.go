@@ -45,6 +45,11 @@ var (
 		return s.Strikethrough(true)
 	}
 
+	// Faint is a style option that sets the faint property of a lipgloss style.
+	Faint tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
+		return s.Faint(true)
+	}
+
 	// Upper is a style option that sets the transform function of a lipgloss style to strings.ToUpper.
 	// It transforms the text to uppercase.
 	Upper tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
@@ -57,92 +62,92 @@ var (
 		return s.Transform(strings.ToLower)
 	}
 
-	// NormalText is a style option that sets the bold, italic, underline, and strikethrough properties of a lipgloss style to false.
+	// NormalText is a style option that sets the bold, italic, underline, strikethrough, and faint properties of a lipgloss style to false.
 	// It also removes the transform function from the style.
 	NormalText tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Bold(false).Strikethrough(false).Italic(false).Underline(false).Transform(func(str string) string {
+		return s.Bold(false).Strikethrough(false).Italic(false).Underline(false).Faint(false).Transform(func(str string) string {
 			return str
 		})
 	}
 
 	// Accent is a style option that sets the foreground color of a lipgloss style to the accent color.
 	Accent tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorAccent)
+		return s.Foreground(tui.CurrentPalette().Accent)
 	}
 
 	// Bright is a style option that sets the foreground color of a lipgloss style to the bright color.
 	Bright tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorBright)
+		return s.Foreground(tui.CurrentPalette().Bright)
 	}
 
 	// Muted is a style option that sets the foreground color of a lipgloss style to the muted color.
 	Muted tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorMuted)
+		return s.Foreground(tui.CurrentPalette().Muted)
 	}
 
 	// LightMuted is a style option that sets the foreground color of a lipgloss style to the light muted color.
 	LightMuted tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorLightMuted)
+		return s.Foreground(tui.CurrentPalette().LightMuted)
 	}
 
 	// Error is a style option that sets the foreground color of a lipgloss style to the error color.
 	Error tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorError)
+		return s.Foreground(tui.CurrentPalette().Error)
 	}
 
 	// Success is a style option that sets the foreground color of a lipgloss style to the success color.
 	Success tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorSuccess)
+		return s.Foreground(tui.CurrentPalette().Success)
 	}
 
 	// Warning is a style option that sets the foreground color of a lipgloss style to the warning color.
 	Warning tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorWarning)
+		return s.Foreground(tui.CurrentPalette().Warning)
 	}
 
 	// Info is a style option that sets the foreground color of a lipgloss style to the info color.
 	Info tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorInfo)
+		return s.Foreground(tui.CurrentPalette().Info)
 	}
 
 	// Link is a style option that sets the foreground color of a lipgloss style to the link color and underlines the text.
 	BackAccent tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorAccent)
+		return s.Background(tui.CurrentPalette().Accent)
 	}
 
 	// BackBright is a style option that sets the background color of a lipgloss style to the bright color.
 	BackBright tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorBright)
+		return s.Background(tui.CurrentPalette().Bright)
 	}
 
 	// BackMuted is a style option that sets the background color of a lipgloss style to the muted color.
 	BackMuted tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorMuted)
+		return s.Background(tui.CurrentPalette().Muted)
 	}
 
 	// BackLightMuted is a style option that sets the background color of a lipgloss style to the light muted color.
 	BackLightMuted tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorLightMuted)
+		return s.Background(tui.CurrentPalette().LightMuted)
 	}
 
 	// BackError is a style option that sets the background color of a lipgloss style to the error color.
 	BackError tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorError)
+		return s.Background(tui.CurrentPalette().Error)
 	}
 
 	// BackSuccess is a style option that sets the background color of a lipgloss style to the success color.
 	BackSuccess tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorSuccess)
+		return s.Background(tui.CurrentPalette().Success)
 	}
 
 	// BackWarning is a style option that sets the background color of a lipgloss style to the warning color.
 	BackWarning tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorWarning)
+		return s.Background(tui.CurrentPalette().Warning)
 	}
 
 	// BackInfo is a style option that sets the background color of a lipgloss style to the info color.
 	BackInfo tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Background(tui.ColorInfo)
+		return s.Background(tui.CurrentPalette().Info)
 	}
 
 	// Left is a style option that aligns the text to the left.
@@ -177,14 +182,35 @@ var (
 
 	// Link is a style option that sets the foreground color of a lipgloss style to the link color and underlines the text.
 	Link tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
-		return s.Foreground(tui.ColorLink).Underline(true).Inline(true)
+		return s.Foreground(tui.CurrentPalette().Link).Underline(true).Inline(true)
 	}
 
 	// Quote is a style option that sets the style of a quote. It adds a border to the left side of the text.
+	// The padding and margins follow the density of the theme (see tui.Spacing).
 	Quote tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
 		s = Color(nil)(s)
-		return s.Border(lipgloss.ThickBorder(), false, false, false, true).BorderForeground(tui.ColorMuted).PaddingLeft(2).Margin(1, 0)
+		return s.Border(tui.AdaptBorder(lipgloss.ThickBorder()), false, false, false, true).BorderForeground(tui.CurrentPalette().Muted).PaddingLeft(tui.Spacing(2)).Margin(tui.Spacing(1), 0)
+	}
+
+	// Rainbow is a style option that colors the text with a rainbow gradient (see tui.RainbowText).
+	// A transform already set on the style (e.g. Upper) is applied first.
+	Rainbow tui.StyleOption = func(s lipgloss.Style) lipgloss.Style {
+		return chainTransform(s, tui.RainbowText)
 	}
+
+	// Rounded is a style option that adds a rounded border on all sides.
+	Rounded tui.StyleOption = Border(lipgloss.RoundedBorder())
+
+	// Thick is a style option that adds a thick border on all sides.
+	Thick tui.StyleOption = Border(lipgloss.ThickBorder())
+
+	// Double is a style option that adds a double border on all sides.
+	Double tui.StyleOption = Border(lipgloss.DoubleBorder())
+
+	// Hidden is a style option that adds an invisible border on all sides.
+	// It takes the same space as a visible border, so it is useful to keep
+	// framed and unframed panels aligned.
+	Hidden tui.StyleOption = Border(lipgloss.HiddenBorder())
 )
 
 // Width returns a style option that sets the width of a lipgloss style.
@@ -283,6 +309,35 @@ func Padding(paddings ...int) tui.StyleOption {
 	}
 }
 
+// Border returns a style option that sets the border of a lipgloss style.
+// It takes a lipgloss border and a list of booleans as input.
+// The booleans select the sides of the border, in the same way as the margins:
+//   - One boolean: sets the border on all sides.
+//   - Two booleans: the first sets the top and bottom borders, the second the left and right borders.
+//   - Three booleans: sets the top, left and right, and bottom borders.
+//   - Four booleans: sets the top, right, bottom, and left borders.
+//   - No booleans: sets the border on all sides.
+//
+// The border is replaced with its ASCII variant on terminals without Unicode support (see tui.AdaptBorder).
+func Border(style lipgloss.Border, sides ...bool) tui.StyleOption {
+	return func(s lipgloss.Style) lipgloss.Style {
+		return s.Border(tui.AdaptBorder(style), sides...)
+	}
+}
+
+// BorderColor returns a style option that sets the border color of a lipgloss style.
+// It takes a list of lipgloss terminal colors as input, assigned to the sides
+// in the same way as the margins (see Margin).
+// No colors: unset the border colors.
+func BorderColor(colors ...lipgloss.TerminalColor) tui.StyleOption {
+	return func(s lipgloss.Style) lipgloss.Style {
+		if len(colors) == 0 {
+			return s.UnsetBorderForeground()
+		}
+		return s.BorderForeground(colors...)
+	}
+}
+
 // Color returns a style option that sets the color of a lipgloss style.
 // It takes a list of lipgloss terminal colors as input and sets the color of the lipgloss style.
 //   - One color: sets the foreground color.
@@ -330,6 +385,31 @@ func Color(color ...lipgloss.TerminalColor) tui.StyleOption {
 	}
 }
 
+// Gradient returns a style option that colors the text with a horizontal
+// gradient between two colors (see tui.GradientText).
+// It replaces the foreground color of the style, but keeps the other styles (e.g. bold).
+// A transform already set on the style (e.g. Upper) is applied first.
+func Gradient(from, to lipgloss.Color) tui.StyleOption {
+	return func(s lipgloss.Style) lipgloss.Style {
+		return chainTransform(s, func(str string) string {
+			return tui.GradientText(str, from, to)
+		})
+	}
+}
+
+// chainTransform function sets a transform on the style that runs after the
+// transform already set on it, if any.
+func chainTransform(s lipgloss.Style, f func(string) string) lipgloss.Style {
+	prev := s.GetTransform()
+	if prev == nil {
+		return s.Transform(f)
+	}
+
+	return s.Transform(func(str string) string {
+		return f(prev(str))
+	})
+}
+
 // Heading returns a style option that sets the style of a heading.
 // It takes an integer as input and sets the style of the heading based on the level.
 // The level determines the size and style of the heading.
@@ -339,12 +419,14 @@ func Color(color ...lipgloss.TerminalColor) tui.StyleOption {
 //   - 4: sets the style of the heading to the fourth largest size and removes the underline.
 //   - 5: sets the style of the heading to the fifth largest size and removes the border.
 //   - More than 4: no effect.
+//
+// The margins follow the density of the theme (see tui.Spacing).
 func Heading(level int) tui.StyleOption {
 	return func(s lipgloss.Style) lipgloss.Style {
 		if level <= 5 && level > 0 {
-			s = s.Foreground(tui.ColorBright).Bold(true).Inline(true)
+			s = s.Foreground(tui.CurrentPalette().Bright).Bold(true).Inline(true)
 			if level < 5 {
-				s = s.Inline(false).MarginBottom(1)
+				s = s.Inline(false).MarginBottom(tui.Spacing(1))
 			}
 			if level < 4 {
 				s = s.Transform(strings.ToUpper)
@@ -353,7 +435,7 @@ func Heading(level int) tui.StyleOption {
 				s = s.Underline(true)
 			}
 			if level < 2 {
-				s = s.MarginBottom(2).Border(lipgloss.NormalBorder(), false, false, true, false).BorderForeground(tui.ColorLightMuted).Underline(false)
+				s = s.MarginBottom(tui.Spacing(2)).Border(tui.AdaptBorder(lipgloss.NormalBorder()), false, false, true, false).BorderForeground(tui.CurrentPalette().LightMuted).Underline(false)
 			}
 		}
 		return s
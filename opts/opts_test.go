@@ -0,0 +1,74 @@
+package opts
+
+import (
+	"testing"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/tuitest"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+)
+
+func TestMain(m *testing.M) {
+	tuitest.Main(m)
+}
+
+func TestGradientTransform(t *testing.T) {
+	defer lipgloss.SetColorProfile(lipgloss.ColorProfile())
+	lipgloss.SetColorProfile(termenv.TrueColor)
+
+	tests := []struct {
+		name     string
+		options  []tui.StyleOption
+		expected string
+	}{
+		{name: "Upper, Gradient", options: []tui.StyleOption{Upper, Gradient("#ff0000", "#0000ff")}, expected: "HELLO"},
+		{name: "Upper, Rainbow", options: []tui.StyleOption{Upper, Rainbow}, expected: "HELLO"},
+		{name: "Gradient", options: []tui.StyleOption{Gradient("#ff0000", "#0000ff")}, expected: "hello"},
+	}
+
+	for _, test := range tests {
+		result := tui.Render("hello", test.options...)
+		if ansi.Strip(result) != test.expected || result == test.expected {
+			t.Errorf("Render(%q, %s) = %q; expected %q with a gradient", "hello", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestBorder(t *testing.T) {
+	tests := []struct {
+		name     string
+		option   tui.StyleOption
+		expected [4]bool // top, right, bottom, left
+	}{
+		{name: "Border()", option: Border(lipgloss.NormalBorder()), expected: [4]bool{true, true, true, true}},
+		{name: "Border(false)", option: Border(lipgloss.NormalBorder(), false), expected: [4]bool{false, false, false, false}},
+		{name: "Border(true, false)", option: Border(lipgloss.NormalBorder(), true, false), expected: [4]bool{true, false, true, false}},
+		{name: "Border(false, true)", option: Border(lipgloss.NormalBorder(), false, true), expected: [4]bool{false, true, false, true}},
+		{name: "Border(true, false, false)", option: Border(lipgloss.NormalBorder(), true, false, false), expected: [4]bool{true, false, false, false}},
+		{name: "Border(false, true, false, true)", option: Border(lipgloss.NormalBorder(), false, true, false, true), expected: [4]bool{false, true, false, true}},
+		{name: "Rounded", option: Rounded, expected: [4]bool{true, true, true, true}},
+	}
+
+	for _, test := range tests {
+		s := test.option(lipgloss.NewStyle())
+		result := [4]bool{s.GetBorderTop(), s.GetBorderRight(), s.GetBorderBottom(), s.GetBorderLeft()}
+		if result != test.expected {
+			t.Errorf("%s sets the sides %v; expected %v", test.name, result, test.expected)
+		}
+	}
+}
+
+func TestBorderColor(t *testing.T) {
+	red := lipgloss.Color("#ff0000")
+	s := BorderColor(red)(lipgloss.NewStyle())
+	if c := s.GetBorderTopForeground(); c != red {
+		t.Errorf("BorderColor(%q) sets the top color %v; expected %v", red, c, red)
+	}
+
+	s = BorderColor()(s)
+	if c := s.GetBorderTopForeground(); c != (lipgloss.NoColor{}) {
+		t.Errorf("BorderColor() sets the top color %v; expected no color", c)
+	}
+}
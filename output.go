@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// output
+var (
+	outputMu sync.RWMutex
+	output   io.Writer = os.Stdout
+)
+
+// SetOutput function sets the writer used by Print (stdout by default).
+// The default lipgloss renderer is replaced with one bound to the writer, so
+// the colors are detected for the new output: styles rendered for a file or
+// a buffer have no colors, styles rendered for a terminal keep them.
+// The profile (see CurrentProfile) is detected again for the writer and the
+// plain mode is enabled only if the writer is not a terminal, unless it was
+// enabled with SetPlain. Call SetProfile afterwards to force a different profile.
+func SetOutput(w io.Writer) {
+	outputMu.Lock()
+	output = w
+	lipgloss.SetDefaultRenderer(lipgloss.NewRenderer(w))
+	outputMu.Unlock()
+
+	profileMu.Lock()
+	defer profileMu.Unlock()
+	adaptProfile(w)
+}
+
+// Output function returns the writer used by Print.
+func Output() io.Writer {
+	outputMu.RLock()
+	defer outputMu.RUnlock()
+	return output
+}
+
+// Print function writes rendered strings to the output (see SetOutput).
+// It takes a list of strings as input and writes each of them on its own line.
+// In plain mode (see SetPlain) the strings are converted with Plain.
+func Print(strs ...string) error {
+	return Fprint(Output(), strs...)
+}
+
+// Fprint function writes rendered strings to the provided writer.
+// It takes a writer and a list of strings as input and writes each of the
+// strings on its own line.
+// In plain mode (see SetPlain) the strings are converted with Plain.
+func Fprint(w io.Writer, strs ...string) error {
+	s := strings.Join(strs, "\n")
+	if IsPlain() {
+		s = Plain(s)
+	}
+
+	_, err := io.WriteString(w, s+"\n")
+	return err
+}
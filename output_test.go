@@ -0,0 +1,37 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestPrint(t *testing.T) {
+	// the profile is restored after the output, since SetOutput detects it again
+	restoreProfile(t)
+	defer SetOutput(os.Stdout)
+
+	var b bytes.Buffer
+	SetOutput(&b)
+	if err := Print("first", "second"); err != nil {
+		t.Errorf("Print returned error %v", err)
+	}
+	if b.String() != "first\nsecond\n" {
+		t.Errorf("Print wrote %q; expected %q", b.String(), "first\nsecond\n")
+	}
+
+	if p := CurrentProfile(); !IsPlain() || p != (Profile{}) {
+		t.Errorf("SetOutput(buffer) set the plain mode to %v and the profile to %+v; expected true and the plain profile", IsPlain(), p)
+	}
+
+	b.Reset()
+	SetPlain(true)
+	SetOutput(&b)
+	SetOutput(os.Stdout)
+	if !IsPlain() {
+		t.Errorf("SetOutput(os.Stdout) disabled the plain mode set with SetPlain")
+	}
+	if err := Fprint(&b, "\x1b[1m╭─╮\x1b[0m"); err != nil || b.String() != "+-+\n" {
+		t.Errorf("Fprint in plain mode wrote %q, %v; expected %q, nil", b.String(), err, "+-+\n")
+	}
+}
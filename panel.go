@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Panel function returns a content framed by a border, with a title embedded
+// in the top border line (e.g. "╭─ Title ───╮").
+// It takes a title, a content, the position of the title (lipgloss.Left,
+// lipgloss.Center, lipgloss.Right or any value in between) and a list of
+// style options as input.
+// By default the border is rounded and muted, with a horizontal padding of 1
+// (adjusted to the density of the theme, see Spacing); the style options are
+// applied afterwards, so they can change the border, the colors and the sizes.
+// If the style options set no width, the panel is widened to fit the title;
+// otherwise the title is truncated if it does not fit in the top border.
+// If the style has no top border, the title is not rendered.
+func Panel(title, content string, position lipgloss.Position, options ...StyleOption) string {
+	style := NewStyle(append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		return s.Border(AdaptBorder(lipgloss.RoundedBorder())).BorderForeground(CurrentPalette().Muted).Padding(0, Spacing(1))
+	}}, options...)...)
+
+	// the margins are applied after the title is embedded, so that the top
+	// line of the rendered string is the top border
+	top, right, bottom, left := style.GetMargin()
+	if style.GetBorderTop() && title != "" && style.GetWidth() <= 0 {
+		// keep room for the title, a space around it and a border cell on each side
+		minimum := lipgloss.Width(title) + 4
+		if lipgloss.Width(content)+style.GetHorizontalPadding() < minimum {
+			style = style.Width(minimum)
+		}
+	}
+	rendered := style.UnsetMargins().Render(content)
+	if style.GetBorderTop() && title != "" {
+		lines := strings.Split(rendered, "\n")
+		lines[0] = titledBorder(style, title, lipgloss.Width(lines[0]), position)
+		rendered = strings.Join(lines, "\n")
+	}
+
+	return lipgloss.NewStyle().Margin(top, right, bottom, left).Render(rendered)
+}
+
+// titledBorder function returns the top border line of a style with the title embedded.
+// It takes the style, the title, the total width of the line and the position of the title as input.
+func titledBorder(style lipgloss.Style, title string, width int, position lipgloss.Position) string {
+	b := style.GetBorderStyle()
+	borderStyle := lipgloss.NewStyle().Foreground(style.GetBorderTopForeground()).Background(style.GetBorderTopBackground())
+
+	leftCorner, rightCorner := "", ""
+	if style.GetBorderLeft() {
+		leftCorner = b.TopLeft
+	}
+	if style.GetBorderRight() {
+		rightCorner = b.TopRight
+	}
+
+	// keep at least one border cell on each side of the title and a space
+	// around it
+	inner := width - lipgloss.Width(leftCorner) - lipgloss.Width(rightCorner)
+	if inner < 5 || b.Top == "" {
+		return borderStyle.Render(leftCorner + strings.Repeat(b.Top, max(inner, 0)) + rightCorner)
+	}
+
+	title = " " + TruncateString(title, inner-4) + " "
+	free := inner - lipgloss.Width(title)
+	before := min(max(int(math.Round(float64(free)*float64(position))), 1), free-1)
+
+	return borderStyle.Render(leftCorner+strings.Repeat(b.Top, before)) +
+		lipgloss.NewStyle().Foreground(CurrentPalette().Bright).Background(style.GetBorderTopBackground()).Bold(true).Render(title) +
+		borderStyle.Render(strings.Repeat(b.Top, free-before)+rightCorner)
+}
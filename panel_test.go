@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestPanel(t *testing.T) {
+	tests := []struct {
+		title    string
+		content  string
+		position lipgloss.Position
+		options  []StyleOption
+		expected string
+	}{
+		{
+			title:    "Logs",
+			content:  "hello world",
+			position: lipgloss.Left,
+			expected: "╭─ Logs ──────╮\n│ hello world │\n╰─────────────╯",
+		},
+		{
+			title:    "Logs",
+			content:  "hello world",
+			position: lipgloss.Right,
+			expected: "╭────── Logs ─╮\n│ hello world │\n╰─────────────╯",
+		},
+		{
+			title:    "A very long title",
+			content:  "hello world",
+			position: lipgloss.Center,
+			expected: "╭─ A very long title ─╮\n│ hello world         │\n╰─────────────────────╯",
+		},
+		{
+			title:    "A very long title",
+			content:  "hello world",
+			position: lipgloss.Center,
+			options:  []StyleOption{func(s lipgloss.Style) lipgloss.Style { return s.Width(13) }},
+			expected: "╭─ A very... ─╮\n│ hello world │\n╰─────────────╯",
+		},
+		{
+			title:    "Logs",
+			content:  "hi",
+			position: lipgloss.Center,
+			expected: "╭─ Logs ─╮\n│ hi     │\n╰────────╯",
+		},
+		{
+			title:    "Logs",
+			content:  "ok",
+			position: 0,
+			expected: "╭─ Logs ─╮\n│ ok     │\n╰────────╯",
+		},
+	}
+
+	for _, test := range tests {
+		result := Panel(test.title, test.content, test.position, test.options...)
+		if result != test.expected {
+			t.Errorf("Panel(%q, %q, %v) = %q; expected %q", test.title, test.content, test.position, result, test.expected)
+		}
+	}
+}
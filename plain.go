@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// plain mode
+// The plain mode is guarded by profileMu, the fallback width by sizeMu.
+var (
+	plain         bool
+	plainForced   bool // true if the plain mode was enabled with SetPlain
+	plainBackup   Profile
+	fallbackWidth = 80
+)
+
+// SetPlain function toggles the plain mode.
+// In plain mode the output has no colors, no hyperlinks and only ASCII
+// glyphs and borders (see SetProfile), and the terminal width falls back to
+// the fallback width (see SetFallbackWidth).
+// The plain mode is enabled automatically when the output is not a terminal
+// (see SetOutput); once enabled with SetPlain, it is kept when the output changes.
+// When disabled, the profile in use before enabling it is restored.
+func SetPlain(enabled bool) {
+	profileMu.Lock()
+	defer profileMu.Unlock()
+	plainForced = enabled
+	if enabled == plain {
+		return
+	}
+
+	if enabled {
+		plainBackup = profile
+		plain = true
+		setProfile(Profile{})
+		return
+	}
+
+	plain = false
+	setProfile(plainBackup)
+}
+
+// IsPlain function reports whether the plain mode is enabled.
+func IsPlain() bool {
+	profileMu.RLock()
+	defer profileMu.RUnlock()
+	return plain
+}
+
+// SetFallbackWidth function sets the width used when the size of the
+// terminal cannot be detected (e.g. when the output is piped).
+// The default fallback width is 80. If the width is less than 0, it sets it to 0.
+func SetFallbackWidth(width int) {
+	sizeMu.Lock()
+	defer sizeMu.Unlock()
+	fallbackWidth = max(width, 0)
+}
+
+// plainReplacer replaces the box-drawing runes and the glyphs with ASCII.
+var plainReplacer = strings.NewReplacer(
+	"─", "-", "━", "-", "═", "=",
+	"│", "|", "┃", "|", "║", "|",
+	"┌", "+", "┐", "+", "└", "+", "┘", "+",
+	"╭", "+", "╮", "+", "╰", "+", "╯", "+",
+	"┏", "+", "┓", "+", "┗", "+", "┛", "+",
+	"╔", "+", "╗", "+", "╚", "+", "╝", "+",
+	"├", "+", "┤", "+", "┬", "+", "┴", "+", "┼", "+",
+	"╠", "+", "╣", "+", "╦", "+", "╩", "+", "╬", "+",
+	"•", "*", "·", "-", "→", "->", "…", "...",
+	"✔", "v", "✘", "x", "⚠", "!", "ℹ", "i", "■", "#", "█", "#", "░", "-",
+)
+
+// Plain function returns a rendered string as plain text.
+// It takes a string as input and returns it without ANSI sequences and with
+// the box-drawing runes and the glyphs of the package replaced by ASCII
+// characters. It is useful to write already rendered output to a file or a pipe.
+func Plain(s string) string {
+	return plainReplacer.Replace(ansi.Strip(s))
+}
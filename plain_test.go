@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestPlain(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "\x1b[1mbold\x1b[0m",
+			expected: "bold",
+		},
+		{
+			input:    "╭──╮\n│ok│\n╰──╯",
+			expected: "+--+\n|ok|\n+--+",
+		},
+		{
+			input:    "✔ done → next",
+			expected: "v done -> next",
+		},
+	}
+
+	for _, test := range tests {
+		result := Plain(test.input)
+		if result != test.expected {
+			t.Errorf("Plain(%q) = %q; expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+// restoreProfile function restores the profile and the plain mode at the end
+// of a test, without marking the plain mode as set with SetPlain.
+func restoreProfile(t *testing.T) {
+	profileMu.RLock()
+	p, wasPlain, wasForced, backup := profile, plain, plainForced, plainBackup
+	profileMu.RUnlock()
+
+	t.Cleanup(func() {
+		profileMu.Lock()
+		defer profileMu.Unlock()
+		plain, plainForced, plainBackup = wasPlain, wasForced, backup
+		setProfile(p)
+	})
+}
+
+func TestSetPlain(t *testing.T) {
+	restoreProfile(t)
+
+	SetPlain(false)
+	SetProfile(Profile{Colors: TrueColor, Unicode: true, Hyperlinks: true})
+	SetPlain(true)
+	if p := CurrentProfile(); p != (Profile{}) || AdaptGlyph(GlyphBullet) != "*" {
+		t.Errorf("SetPlain(true) set the profile to %+v; expected the plain profile", p)
+	}
+
+	SetPlain(false)
+	if p := CurrentProfile(); p != (Profile{Colors: TrueColor, Unicode: true, Hyperlinks: true}) {
+		t.Errorf("SetPlain(false) set the profile to %+v; expected the previous profile", p)
+	}
+}
@@ -0,0 +1,214 @@
+package progress
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/opts"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Bar function renders a progress bar.
+// It takes the completed ratio (from 0 to 1) and the width of the bar as input.
+// The ratio is clamped between 0 and 1; the completed part is rendered with
+// the accent color and the rest with the muted color.
+func Bar(ratio float64, width int) string {
+	full, empty := tui.AdaptGlyph("█"), tui.AdaptGlyph("░")
+
+	width = max(width, 0)
+	filled := int(math.Round(min(max(ratio, 0), 1) * float64(width)))
+	return tui.Render(strings.Repeat(full, filled), opts.Accent, opts.Inline) +
+		tui.Render(strings.Repeat(empty, width-filled), opts.Muted, opts.Inline)
+}
+
+// Task type is a task tracked by a MultiProgress.
+// Its methods are safe for concurrent use.
+type Task struct {
+	parent  *MultiProgress
+	name    string
+	total   int
+	current int
+	weight  float64
+	done    bool
+}
+
+// Increment method adds n to the progress of the task.
+// The task is done when its progress reaches the total.
+func (t *Task) Increment(n int) {
+	t.parent.mu.Lock()
+	defer t.parent.mu.Unlock()
+	t.set(t.current + n)
+}
+
+// Set method sets the progress of the task.
+// The task is done when its progress reaches the total.
+func (t *Task) Set(current int) {
+	t.parent.mu.Lock()
+	defer t.parent.mu.Unlock()
+	t.set(current)
+}
+
+// Done method marks the task as done, regardless of its progress.
+func (t *Task) Done() {
+	t.parent.mu.Lock()
+	defer t.parent.mu.Unlock()
+	t.done = true
+}
+
+// set method sets the progress of the task, clamped between 0 and the total.
+// It must be called with the lock of the parent held.
+func (t *Task) set(current int) {
+	t.current = min(max(current, 0), t.total)
+	t.done = t.done || (t.total > 0 && t.current == t.total)
+}
+
+// ratio method returns the completed ratio of the task.
+// It must be called with the lock of the parent held.
+func (t *Task) ratio() float64 {
+	if t.done {
+		return 1
+	}
+	if t.total <= 0 {
+		return 0
+	}
+	return float64(t.current) / float64(t.total)
+}
+
+// MultiProgress type aggregates the progress of several tasks into an
+// overall progress bar, weighting each task.
+// Its methods are safe for concurrent use, so every task can be updated by
+// its own goroutine while the progress is rendered (e.g. with tui.InPlace).
+// A MultiProgress is rendered with the String method.
+type MultiProgress struct {
+	mu            sync.Mutex
+	tasks         []*Task
+	width         int
+	hideCompleted bool
+}
+
+// New function returns a new MultiProgress without tasks.
+// By default the bars are 30 cells wide and the completed tasks are hidden.
+func New() *MultiProgress {
+	return &MultiProgress{
+		tasks:         make([]*Task, 0),
+		width:         30,
+		hideCompleted: true,
+	}
+}
+
+// Add method adds a task and returns it.
+// It takes the name of the task, the total amount of work and its weight in
+// the overall progress as input. A weight less than or equal to 0 is set to 1.
+// A task with a total less than or equal to 0 has no progress until it is
+// marked as done.
+func (m *MultiProgress) Add(name string, total int, weight float64) *Task {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if weight <= 0 {
+		weight = 1
+	}
+	t := &Task{parent: m, name: name, total: max(total, 0), weight: weight}
+	m.tasks = append(m.tasks, t)
+	return t
+}
+
+// Width method sets the width of the progress bars.
+func (m *MultiProgress) Width(width int) *MultiProgress {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.width = max(width, 0)
+	return m
+}
+
+// HideCompleted method toggles the removal of the completed tasks from the
+// rendered bars. The completed tasks always count in the overall progress.
+func (m *MultiProgress) HideCompleted(enabled bool) *MultiProgress {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hideCompleted = enabled
+	return m
+}
+
+// Ratio method returns the overall completed ratio, from 0 to 1: the average
+// of the ratios of the tasks, weighted by their weights.
+// Without tasks, it returns 0.
+func (m *MultiProgress) Ratio() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ratio()
+}
+
+// ratio method returns the overall completed ratio.
+// It must be called with the lock held.
+func (m *MultiProgress) ratio() float64 {
+	sum, weights := 0.0, 0.0
+	for _, t := range m.tasks {
+		sum += t.ratio() * t.weight
+		weights += t.weight
+	}
+	if weights == 0 {
+		return 0
+	}
+	return sum / weights
+}
+
+// Counts method returns the number of completed tasks and the total number of tasks.
+func (m *MultiProgress) Counts() (int, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.counts()
+}
+
+// counts method returns the number of completed tasks and the total number of tasks.
+// It must be called with the lock held.
+func (m *MultiProgress) counts() (int, int) {
+	done := 0
+	for _, t := range m.tasks {
+		if t.done {
+			done++
+		}
+	}
+	return done, len(m.tasks)
+}
+
+// String method renders the overall progress bar, with the count of the
+// completed tasks (e.g. "3/7 done"), followed by a bar for each task.
+func (m *MultiProgress) String() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	visible := make([]*Task, 0, len(m.tasks))
+	nameWidth := lipgloss.Width("Overall")
+	for _, t := range m.tasks {
+		if m.hideCompleted && t.done {
+			continue
+		}
+		visible = append(visible, t)
+		nameWidth = max(nameWidth, lipgloss.Width(t.name))
+	}
+
+	done, total := m.counts()
+	lines := make([]string, 0, len(visible)+1)
+	lines = append(lines, m.line("Overall", m.ratio(), nameWidth, opts.Bright, opts.Bold)+
+		"  "+tui.Render(fmt.Sprintf("%d/%d done", done, total), opts.Muted, opts.Inline))
+	for _, t := range visible {
+		lines = append(lines, m.line(t.name, t.ratio(), nameWidth, opts.LightMuted))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// line method renders a single progress line: the name, the bar and the percentage.
+// It must be called with the lock held.
+func (m *MultiProgress) line(name string, ratio float64, nameWidth int, options ...tui.StyleOption) string {
+	// the percentage is rounded down, so 100% means done; the epsilon absorbs
+	// the floating point errors (e.g. 0.7*100 = 69.99...)
+	percent := int(ratio*100 + 1e-9)
+	return tui.Render(name, append(options, opts.Width(nameWidth))...) + "  " +
+		Bar(ratio, m.width) + "  " +
+		tui.Render(fmt.Sprintf("%3d%%", percent), opts.Bright, opts.Inline)
+}
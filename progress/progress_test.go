@@ -0,0 +1,69 @@
+package progress
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/tuitest"
+)
+
+func TestMain(m *testing.M) {
+	tuitest.Main(m)
+}
+
+func TestBar(t *testing.T) {
+	tests := []struct {
+		ratio    float64
+		width    int
+		expected string
+	}{
+		{0, 4, "░░░░"},
+		{0.5, 4, "██░░"},
+		{2, 4, "████"},
+		{0.5, 0, ""},
+	}
+
+	for _, test := range tests {
+		result := Bar(test.ratio, test.width)
+		if result != test.expected {
+			t.Errorf("Bar(%v, %d) = %q; expected %q", test.ratio, test.width, result, test.expected)
+		}
+	}
+
+	defer tui.SetProfile(tui.CurrentProfile())
+	tui.SetProfile(tui.Profile{Colors: tui.NoColor})
+	if result := Bar(0.5, 4); result != "##--" {
+		t.Errorf("Bar(%v, %d) without Unicode = %q; expected %q", 0.5, 4, result, "##--")
+	}
+}
+
+func TestMultiProgress(t *testing.T) {
+	m := New().Width(4)
+	build := m.Add("build", 10, 3)
+	test := m.Add("test", 4, 1)
+	m.Add("lint", 0, 1).Done()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			build.Increment(1)
+		}()
+	}
+	wg.Wait()
+	test.Set(4)
+
+	if ratio := m.Ratio(); ratio != 0.7 {
+		t.Errorf("MultiProgress.Ratio() = %v; expected %v", ratio, 0.7)
+	}
+	if done, total := m.Counts(); done != 2 || total != 3 {
+		t.Errorf("MultiProgress.Counts() = %d, %d; expected %d, %d", done, total, 2, 3)
+	}
+
+	expected := "Overall  ███░   70%  2/3 done\nbuild    ██░░   50%"
+	if result := m.String(); result != expected {
+		t.Errorf("MultiProgress.String() = %q; expected %q", result, expected)
+	}
+}
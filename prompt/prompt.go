@@ -0,0 +1,205 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/opts"
+)
+
+// ErrNoChoices is returned by Select.Run when the prompt has no choices.
+var ErrNoChoices = errors.New("prompt: no choices to select from")
+
+// question function renders the question line of a prompt, with the leading "? " icon.
+func question(q string) string {
+	return tui.Render("? ", opts.Accent, opts.Bold, opts.Inline) + tui.Render(q, opts.Bright, opts.Bold, opts.Inline)
+}
+
+// readLine function reads a line from the reader, up to and including the
+// newline. The reader is read one byte at a time, so that nothing past the
+// newline is consumed and the next prompt reading from the same reader gets
+// the rest of the input. If the reader implements io.ByteReader (e.g. a
+// bufio.Reader), its buffered bytes are used.
+func readLine(r io.Reader) (string, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = &byteReader{r: r}
+	}
+
+	var line strings.Builder
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return line.String(), err
+		}
+		line.WriteByte(b)
+		if b == '\n' {
+			return line.String(), nil
+		}
+	}
+}
+
+// byteReader type reads a reader one byte at a time, without buffering.
+type byteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+// ReadByte method reads a single byte from the underlying reader.
+func (b *byteReader) ReadByte() (byte, error) {
+	for {
+		n, err := b.r.Read(b.buf[:])
+		if n == 1 {
+			return b.buf[0], nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Confirm type is a yes/no prompt.
+type Confirm struct {
+	question string
+	def      bool
+	in       io.Reader
+	out      io.Writer
+}
+
+// NewConfirm function returns a yes/no prompt asking the provided question.
+// By default the answer is "no" and the prompt reads from stdin and writes to stdout.
+func NewConfirm(q string) *Confirm {
+	return &Confirm{question: q, in: os.Stdin, out: os.Stdout}
+}
+
+// Default method sets the answer returned when the user just presses enter.
+func (c *Confirm) Default(answer bool) *Confirm {
+	c.def = answer
+	return c
+}
+
+// IO method sets the reader and the writer used by Run.
+// The reader is never read past the answer, so it can be shared by several prompts.
+func (c *Confirm) IO(in io.Reader, out io.Writer) *Confirm {
+	c.in, c.out = in, out
+	return c
+}
+
+// String method renders the prompt line (e.g. "? Continue? (y/N)").
+func (c *Confirm) String() string {
+	hint := "(y/N)"
+	if c.def {
+		hint = "(Y/n)"
+	}
+
+	return question(c.question) + " " + tui.Render(hint, opts.Muted, opts.Inline)
+}
+
+// Run method prints the prompt and blocks until the user answers.
+// An empty answer returns the default; "y", "yes", "n" and "no" are
+// accepted in any case. Any other answer prints a hint and asks again.
+// If the input ends before an answer is given, it returns the default and the read error.
+func (c *Confirm) Run() (bool, error) {
+	for {
+		fmt.Fprint(c.out, c.String()+" ")
+		line, err := readLine(c.in)
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "":
+			if err != nil {
+				return c.def, err
+			}
+			return c.def, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		if err != nil {
+			return c.def, err
+		}
+
+		fmt.Fprintln(c.out, tui.Render("please answer y or n", opts.Error, opts.Inline))
+	}
+}
+
+// Select type is a prompt where the user selects one of several choices.
+type Select struct {
+	question string
+	choices  []string
+	def      int
+	in       io.Reader
+	out      io.Writer
+}
+
+// NewSelect function returns a prompt asking the user to select one of the choices.
+// By default the first choice is selected and the prompt reads from stdin and writes to stdout.
+func NewSelect(q string, choices ...string) *Select {
+	return &Select{question: q, choices: choices, in: os.Stdin, out: os.Stdout}
+}
+
+// Default method sets the index of the choice returned when the user just presses enter.
+// If the index is out of range, it is ignored.
+func (s *Select) Default(index int) *Select {
+	if index >= 0 && index < len(s.choices) {
+		s.def = index
+	}
+	return s
+}
+
+// IO method sets the reader and the writer used by Run.
+// The reader is never read past the answer, so it can be shared by several prompts.
+func (s *Select) IO(in io.Reader, out io.Writer) *Select {
+	s.in, s.out = in, out
+	return s
+}
+
+// String method renders the prompt: the question followed by the numbered choices.
+// The default choice is highlighted with the accent color.
+func (s *Select) String() string {
+	lines := []string{question(s.question)}
+	width := len(strconv.Itoa(len(s.choices)))
+	for i, choice := range s.choices {
+		n := tui.Render(tui.FormatIntWithPrefix(i+1, width)+")", opts.Muted, opts.Inline)
+		if i == s.def {
+			choice = tui.Render(choice, opts.Accent, opts.Inline)
+		}
+		lines = append(lines, "  "+n+" "+choice)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Run method prints the prompt and blocks until the user selects a choice.
+// It returns the index and the value of the selected choice.
+// An empty answer returns the default choice; any answer that is not a valid
+// choice number prints a hint and asks again.
+// If the input ends before an answer is given, it returns the default choice and the read error.
+func (s *Select) Run() (int, string, error) {
+	if len(s.choices) == 0 {
+		return -1, "", ErrNoChoices
+	}
+
+	fmt.Fprintln(s.out, s.String())
+	for {
+		fmt.Fprint(s.out, tui.Render(fmt.Sprintf("Enter a number (%d):", s.def+1), opts.Muted, opts.Inline)+" ")
+		line, err := readLine(s.in)
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return s.def, s.choices[s.def], err
+		}
+
+		if n, convErr := strconv.Atoi(line); convErr == nil && n >= 1 && n <= len(s.choices) {
+			return n - 1, s.choices[n-1], nil
+		}
+		if err != nil {
+			return s.def, s.choices[s.def], err
+		}
+
+		fmt.Fprintln(s.out, tui.Render(fmt.Sprintf("please enter a number between 1 and %d", len(s.choices)), opts.Error, opts.Inline))
+	}
+}
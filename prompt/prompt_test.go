@@ -0,0 +1,101 @@
+package prompt
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestConfirmRun(t *testing.T) {
+	tests := []struct {
+		input    string
+		def      bool
+		expected bool
+	}{
+		{
+			input:    "y\n",
+			def:      false,
+			expected: true,
+		},
+		{
+			input:    "NO\n",
+			def:      true,
+			expected: false,
+		},
+		{
+			input:    "\n",
+			def:      true,
+			expected: true,
+		},
+		{
+			input:    "maybe\nyes\n",
+			def:      false,
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := NewConfirm("Continue?").Default(test.def).IO(strings.NewReader(test.input), io.Discard).Run()
+		if err != nil || result != test.expected {
+			t.Errorf("Confirm.Run() with input %q = %v, %v; expected %v, nil", test.input, result, err, test.expected)
+		}
+	}
+
+	if _, err := NewConfirm("Continue?").IO(strings.NewReader(""), io.Discard).Run(); err != io.EOF {
+		t.Errorf("Confirm.Run() with empty input returned error %v; expected %v", err, io.EOF)
+	}
+}
+
+func TestSelectRun(t *testing.T) {
+	tests := []struct {
+		input    string
+		def      int
+		expected string
+	}{
+		{
+			input:    "2\n",
+			def:      0,
+			expected: "green",
+		},
+		{
+			input:    "\n",
+			def:      2,
+			expected: "blue",
+		},
+		{
+			input:    "7\nred\n1\n",
+			def:      0,
+			expected: "red",
+		},
+	}
+
+	for _, test := range tests {
+		_, result, err := NewSelect("Color?", "red", "green", "blue").Default(test.def).IO(strings.NewReader(test.input), io.Discard).Run()
+		if err != nil || result != test.expected {
+			t.Errorf("Select.Run() with input %q = %q, %v; expected %q, nil", test.input, result, err, test.expected)
+		}
+	}
+
+	if _, _, err := NewSelect("Color?").IO(strings.NewReader("1\n"), io.Discard).Run(); err != ErrNoChoices {
+		t.Errorf("Select.Run() without choices returned error %v; expected %v", err, ErrNoChoices)
+	}
+}
+
+func TestRunInSequence(t *testing.T) {
+	inputs := map[string]io.Reader{
+		"strings.Reader": strings.NewReader("y\n2\n"),
+		"io.Reader":      io.MultiReader(strings.NewReader("y\n2\n")),
+	}
+
+	for name, in := range inputs {
+		confirmed, err := NewConfirm("Continue?").IO(in, io.Discard).Run()
+		if err != nil || !confirmed {
+			t.Errorf("Confirm.Run() with a %s = %v, %v; expected true, nil", name, confirmed, err)
+		}
+
+		_, choice, err := NewSelect("Color?", "red", "green", "blue").IO(in, io.Discard).Run()
+		if err != nil || choice != "green" {
+			t.Errorf("Select.Run() after Confirm.Run() with a %s = %q, %v; expected %q, nil", name, choice, err, "green")
+		}
+	}
+}
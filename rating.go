@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// rating symbols
+var (
+	RatingFull  = "★"
+	RatingHalf  = "⯪"
+	RatingEmpty = "☆"
+)
+
+// RenderRating function returns a read-only rating (e.g. ★★★☆☆).
+// It takes a value, the maximum value and a list of style options as input.
+// The value is rounded to the nearest half and clamped between 0 and the maximum.
+// The full and half symbols are rendered with the accent color, the empty
+// symbols with the muted color. The style options are applied to the full
+// and half symbols after the accent color, so they can override it.
+// If the maximum is less than or equal to 0, it returns an empty string.
+func RenderRating(value float64, maximum int, options ...StyleOption) string {
+	if maximum <= 0 {
+		return ""
+	}
+
+	value = math.Round(value*2) / 2
+	value = min(max(value, 0), float64(maximum))
+	full := int(value)
+	half := value-float64(full) > 0
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat(AdaptGlyph(RatingFull), full))
+	if half {
+		b.WriteString(AdaptGlyph(RatingHalf))
+		full++
+	}
+
+	filled := Render(b.String(), append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		return s.Foreground(CurrentPalette().Accent).Inline(true)
+	}}, options...)...)
+	empty := Render(strings.Repeat(AdaptGlyph(RatingEmpty), maximum-full), func(s lipgloss.Style) lipgloss.Style {
+		return s.Foreground(CurrentPalette().Muted).Inline(true)
+	})
+
+	return filled + empty
+}
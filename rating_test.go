@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestRenderRating(t *testing.T) {
+	tests := []struct {
+		value    float64
+		maximum  int
+		expected string
+	}{
+		{
+			value:    3,
+			maximum:  5,
+			expected: "★★★☆☆",
+		},
+		{
+			value:    2.6,
+			maximum:  5,
+			expected: "★★⯪☆☆",
+		},
+		{
+			value:    7,
+			maximum:  5,
+			expected: "★★★★★",
+		},
+		{
+			value:    -1,
+			maximum:  3,
+			expected: "☆☆☆",
+		},
+		{
+			value:    3,
+			maximum:  0,
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		result := RenderRating(test.value, test.maximum)
+		if result != test.expected {
+			t.Errorf("RenderRating(%v, %d) = %q; expected %q", test.value, test.maximum, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package tui
+
+import "context"
+
+// WatchResize function calls a callback every time the terminal is resized,
+// so that Print-based interfaces can render again with the new size.
+// It takes a context and the callback as input; the callback receives the
+// new width and height of the terminal.
+// The terminal is watched in a background goroutine until the context is done.
+// The cached size of the terminal is invalidated before calling the callback.
+// On Unix systems the resize is detected through the SIGWINCH signal, on
+// Windows the size of the terminal is polled.
+func WatchResize(ctx context.Context, callback func(width, height int)) {
+	events := resizeEvents(ctx)
+	go func() {
+		for range events {
+			InvalidateTerminalSize()
+			callback(getTerminalSize())
+		}
+	}()
+}
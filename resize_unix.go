@@ -0,0 +1,37 @@
+//go:build !windows
+
+package tui
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// resizeEvents function returns a channel receiving a value every time the
+// terminal is resized. The channel is closed when the context is done.
+func resizeEvents(ctx context.Context) <-chan struct{} {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGWINCH)
+
+	events := make(chan struct{})
+	go func() {
+		defer close(events)
+		defer signal.Stop(signals)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				select {
+				case events <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events
+}
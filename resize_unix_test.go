@@ -0,0 +1,56 @@
+//go:build !windows
+
+package tui
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatchResize(t *testing.T) {
+	defer InvalidateTerminalSize()
+
+	// cache a size that the detection does not return, so that the callback
+	// reports a different size only if the cache was invalidated
+	sizeMu.Lock()
+	sizeCached, sizeWidth, sizeHeight = true, 1234, 567
+	sizeMu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sizes := make(chan [2]int, 1)
+	WatchResize(ctx, func(width, height int) {
+		sizes <- [2]int{width, height}
+	})
+	if err := syscall.Kill(os.Getpid(), syscall.SIGWINCH); err != nil {
+		t.Fatalf("sending SIGWINCH: %v", err)
+	}
+
+	select {
+	case size := <-sizes:
+		if size == [2]int{1234, 567} {
+			t.Errorf("WatchResize callback received the cached size %v; expected the cache to be invalidated", size)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("WatchResize callback was not called after SIGWINCH")
+	}
+}
+
+func TestResizeEventsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	events := resizeEvents(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("resizeEvents sent an event after the context was cancelled")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("resizeEvents did not close the channel after the context was cancelled")
+	}
+}
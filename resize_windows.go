@@ -0,0 +1,44 @@
+//go:build windows
+
+package tui
+
+import (
+	"context"
+	"time"
+)
+
+// resizePollInterval is the interval between two checks of the terminal size.
+const resizePollInterval = 250 * time.Millisecond
+
+// resizeEvents function returns a channel receiving a value every time the
+// terminal is resized. Windows has no resize signal, so the size of the
+// terminal is polled. The channel is closed when the context is done.
+func resizeEvents(ctx context.Context) <-chan struct{} {
+	events := make(chan struct{})
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(resizePollInterval)
+		defer ticker.Stop()
+
+		width, height, _ := detectTerminalSize()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w, h, _ := detectTerminalSize()
+				if w == width && h == height {
+					continue
+				}
+				width, height = w, h
+				select {
+				case events <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events
+}
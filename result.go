@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ResultOption type is a function that configures a result block (see ResultBlock).
+type ResultOption func(*resultConfig)
+
+// resultConfig holds the optional sections rendered by ResultBlock.
+type resultConfig struct {
+	elapsed time.Duration
+	hints   []string
+}
+
+// ResultElapsed returns a result option that adds the elapsed time of the operation to the result block.
+func ResultElapsed(elapsed time.Duration) ResultOption {
+	return func(c *resultConfig) {
+		c.elapsed = elapsed
+	}
+}
+
+// ResultHints returns a result option that adds next-steps hints to the result block.
+func ResultHints(hints ...string) ResultOption {
+	return func(c *resultConfig) {
+		c.hints = append(c.hints, hints...)
+	}
+}
+
+// ResultBlock function renders the outcome of an operation.
+// It takes the outcome (true for success), a title, the details and a list
+// of result options as input and returns a bordered block colored with the
+// success or error color, with a check or a cross before the title.
+// The details, the elapsed time and the hints are rendered only if provided.
+// Example:
+//
+//	ResultBlock(true, "Deployed", "3 services updated", ResultElapsed(time.Since(start)), ResultHints("run `app status` to check them"))
+func ResultBlock(ok bool, title, details string, options ...ResultOption) string {
+	config := &resultConfig{}
+	for _, option := range options {
+		option(config)
+	}
+
+	palette := CurrentPalette()
+
+	status := StatusError
+	if ok {
+		status = StatusSuccess
+	}
+
+	lines := []string{
+		lipgloss.NewStyle().Foreground(status.Color()).Bold(true).Render(status.Glyph() + " " + title),
+	}
+	if details = strings.TrimSpace(details); details != "" {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(palette.Bright).Render(details))
+	}
+
+	if config.elapsed > 0 || len(config.hints) > 0 {
+		lines = append(lines, "")
+	}
+	if config.elapsed > 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(palette.Muted).Render("done in "+FormatDuration(config.elapsed)))
+	}
+	for _, hint := range config.hints {
+		lines = append(lines, lipgloss.NewStyle().Foreground(palette.Info).Render(AdaptGlyph(GlyphArrow)+" "+hint))
+	}
+
+	return lipgloss.NewStyle().
+		Border(AdaptBorder(lipgloss.RoundedBorder())).
+		BorderForeground(status.Color()).
+		Padding(0, Spacing(1)).
+		Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResultBlock(t *testing.T) {
+	tests := []struct {
+		ok       bool
+		title    string
+		details  string
+		options  []ResultOption
+		ascii    bool
+		expected string
+	}{
+		{
+			ok:       true,
+			title:    "Deployed",
+			expected: "╭────────────╮\n│ ✔ Deployed │\n╰────────────╯",
+		},
+		{
+			ok:       false,
+			title:    "Failed",
+			expected: "╭──────────╮\n│ ✘ Failed │\n╰──────────╯",
+		},
+		{
+			ok:       true,
+			title:    "Deployed",
+			details:  "  3 services updated\n",
+			expected: "╭────────────────────╮\n│ ✔ Deployed         │\n│                    │\n│ 3 services updated │\n╰────────────────────╯",
+		},
+		{
+			ok:       true,
+			title:    "Deployed",
+			options:  []ResultOption{ResultElapsed(1500 * time.Millisecond)},
+			expected: "╭──────────────╮\n│ ✔ Deployed   │\n│              │\n│ done in 1.5s │\n╰──────────────╯",
+		},
+		{
+			ok:       false,
+			title:    "Failed",
+			details:  "disk full",
+			options:  []ResultOption{ResultElapsed(2 * time.Second), ResultHints("free some space", "retry")},
+			expected: "╭───────────────────╮\n│ ✘ Failed          │\n│                   │\n│ disk full         │\n│                   │\n│ done in 2.0s      │\n│ → free some space │\n│ → retry           │\n╰───────────────────╯",
+		},
+		{
+			ok:       false,
+			title:    "Failed",
+			options:  []ResultOption{ResultHints("retry")},
+			ascii:    true,
+			expected: "+----------+\n| x Failed |\n|          |\n| -> retry |\n+----------+",
+		},
+	}
+
+	defer SetProfile(Profile{Colors: NoColor, Unicode: true})
+	for _, test := range tests {
+		SetProfile(Profile{Colors: NoColor, Unicode: !test.ascii})
+		result := ResultBlock(test.ok, test.title, test.details, test.options...)
+		if result != test.expected {
+			t.Errorf("ResultBlock(%v, %q, %q) = %q; expected %q", test.ok, test.title, test.details, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Rule function renders a horizontal divider drawn with GlyphRule.
+// It takes a list of style options as input, applied after the default muted
+// color, so they can override it.
+// The rule spans the width set by the style options (e.g. opts.Width),
+// borders and paddings included; the margins are added around it.
+// If no width is set, the rule spans the width of the terminal, margins
+// included.
+func Rule(options ...StyleOption) string {
+	return RuleWithGlyph(GlyphRule, "", options...)
+}
+
+// RuleWithTitle function renders a horizontal divider with a centered title
+// (e.g. "──── Title ────").
+// It takes a title and a list of style options as input, like Rule.
+// The title is bold and bright; it is truncated if it does not fit the rule.
+func RuleWithTitle(title string, options ...StyleOption) string {
+	return RuleWithGlyph(GlyphRule, title, options...)
+}
+
+// RuleWithGlyph function renders a horizontal divider drawn with the given
+// glyph instead of GlyphRule (e.g. "═" or "~"), with an optional centered title.
+// It takes the glyph, the title and a list of style options as input, like
+// RuleWithTitle. If the glyph is empty, GlyphRule is used.
+// Example:
+//
+//	RuleWithGlyph("═", "Summary", opts.Width(40))
+func RuleWithGlyph(glyph, title string, options ...StyleOption) string {
+	if glyph == "" {
+		glyph = GlyphRule
+	}
+	glyph = AdaptGlyph(glyph)
+
+	style := NewStyle(append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		return s.Foreground(CurrentPalette().Muted)
+	}}, options...)...)
+
+	// an explicit width already excludes the margins, the terminal width does not
+	width := style.GetWidth()
+	if width > 0 {
+		width -= style.GetHorizontalPadding() + style.GetHorizontalBorderSize()
+	} else {
+		width, _ = getTerminalSize()
+		width -= style.GetHorizontalFrameSize()
+	}
+	width = max(width, 0)
+	style = style.UnsetWidth()
+
+	// wide glyphs leave the cells they cannot fill blank
+	cells := func(n int) string {
+		w := max(lipgloss.Width(glyph), 1)
+		return strings.Repeat(glyph, n/w) + strings.Repeat(" ", n%w)
+	}
+	if title == "" || width == 0 {
+		return style.Render(cells(width))
+	}
+
+	// keep two rule cells and a space on each side of the title, fewer on
+	// narrow rules
+	side, gap := 2, 1
+	if width < 7 {
+		side = 1
+	}
+	if width < 5 {
+		gap = 0
+	}
+	if width < 3 {
+		side = 0
+	}
+	space := strings.Repeat(" ", gap)
+	title = space + TruncateString(title, width-2*side-2*gap) + space
+	left := (width - lipgloss.Width(title)) / 2
+	right := width - lipgloss.Width(title) - left
+	inner := style.UnsetPadding().UnsetMargins().Inline(true)
+
+	return style.Render(
+		inner.Render(cells(left)) +
+			inner.Foreground(CurrentPalette().Bright).Bold(true).Render(title) +
+			inner.Render(cells(right)),
+	)
+}
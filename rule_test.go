@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRuleWithGlyph(t *testing.T) {
+	width := func(w int) StyleOption {
+		return func(s lipgloss.Style) lipgloss.Style {
+			return s.Width(w)
+		}
+	}
+	margin := func(s lipgloss.Style) lipgloss.Style {
+		return s.Margin(0, 2)
+	}
+	padding := func(s lipgloss.Style) lipgloss.Style {
+		return s.Padding(0, 1)
+	}
+
+	tests := []struct {
+		glyph    string
+		title    string
+		options  []StyleOption
+		expected string
+	}{
+		{glyph: "", title: "", options: []StyleOption{width(5)}, expected: "─────"},
+		{glyph: "", title: "Logs", options: []StyleOption{width(12)}, expected: "─── Logs ───"},
+		{glyph: "", title: "Logs", options: []StyleOption{width(13)}, expected: "─── Logs ────"},
+		{glyph: "", title: "A long title", options: []StyleOption{width(12)}, expected: "── A l... ──"},
+		{glyph: "", title: "Logs", options: []StyleOption{width(6)}, expected: "─ Lo ─"},
+		{glyph: "", title: "Logs", options: []StyleOption{width(5)}, expected: "─ L ─"},
+		{glyph: "", title: "Logs", options: []StyleOption{width(3)}, expected: "─L─"},
+		{glyph: "", title: "Logs", options: []StyleOption{width(1)}, expected: "L"},
+		{glyph: "", title: "", options: []StyleOption{width(10), margin}, expected: "  ──────────  "},
+		{glyph: "", title: "", options: []StyleOption{width(10), padding}, expected: " ──────── "},
+		{glyph: "═", title: "", options: []StyleOption{width(4)}, expected: "════"},
+		{glyph: "~", title: "Logs", options: []StyleOption{width(12)}, expected: "~~~ Logs ~~~"},
+	}
+
+	for _, test := range tests {
+		result := RuleWithGlyph(test.glyph, test.title, test.options...)
+		if result != test.expected {
+			t.Errorf("RuleWithGlyph(%q, %q) = %q; expected %q", test.glyph, test.title, result, test.expected)
+		}
+	}
+}
+
+func TestRuleTerminalWidth(t *testing.T) {
+	defer SetTerminalSize(0, 0)
+	SetTerminalSize(10, 5)
+
+	margin := func(s lipgloss.Style) lipgloss.Style {
+		return s.Margin(0, 2)
+	}
+	if result := Rule(margin); result != "  ──────  " {
+		t.Errorf("Rule(margin) with a terminal width of 10 = %q; expected %q", result, "  ──────  ")
+	}
+	if result := RuleWithTitle("Logs"); result != "── Logs ──" {
+		t.Errorf("RuleWithTitle(%q) with a terminal width of 10 = %q; expected %q", "Logs", result, "── Logs ──")
+	}
+}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// scrollbar symbols
+var (
+	ScrollbarTrack = "│"
+	ScrollbarThumb = "┃"
+)
+
+// RenderScrollbar function returns a vertical scrollbar.
+// It takes the height of the visible window, the total number of lines of
+// the content and the offset of the first visible line as input, and returns
+// a single column string of the given height.
+// The size and position of the thumb are proportional to the visible window.
+// If the content fits in the window, the thumb fills the whole scrollbar.
+// If the height is less than or equal to 0, it returns an empty string.
+func RenderScrollbar(height, total, offset int) string {
+	if height <= 0 {
+		return ""
+	}
+
+	thumb, start := height, 0
+	if total > height {
+		offset = min(max(offset, 0), total-height)
+		thumb = max(1, int(math.Round(float64(height*height)/float64(total))))
+		start = int(math.Round(float64((height-thumb)*offset) / float64(total-height)))
+	}
+
+	thumbStyle := lipgloss.NewStyle().Foreground(CurrentPalette().Accent)
+	trackStyle := lipgloss.NewStyle().Foreground(CurrentPalette().Muted)
+	lines := make([]string, height)
+	for i := range lines {
+		if i >= start && i < start+thumb {
+			lines[i] = thumbStyle.Render(AdaptGlyph(ScrollbarThumb))
+		} else {
+			lines[i] = trackStyle.Render(AdaptGlyph(ScrollbarTrack))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ScrollPercent function returns the scroll position as a percentage (e.g. "42%").
+// It takes the height of the visible window, the total number of lines of
+// the content and the offset of the first visible line as input.
+// If the content fits in the window, it returns "100%".
+func ScrollPercent(height, total, offset int) string {
+	if total <= height {
+		return "100%"
+	}
+
+	offset = min(max(offset, 0), total-height)
+	return strconv.Itoa(offset*100/(total-height)) + "%"
+}
+
+// WithScrollbar function adds a scrollbar to the right side of a rendered view.
+// It takes the rendered view, the total number of lines of the content and
+// the offset of the first visible line as input.
+// The height of the scrollbar is the height of the view.
+func WithScrollbar(view string, total, offset int) string {
+	return lipgloss.JoinHorizontal(lipgloss.Top, view, " ", RenderScrollbar(lipgloss.Height(view), total, offset))
+}
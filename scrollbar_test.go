@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestRenderScrollbar(t *testing.T) {
+	tests := []struct {
+		height   int
+		total    int
+		offset   int
+		expected string
+	}{
+		{
+			height:   4,
+			total:    2,
+			offset:   0,
+			expected: "┃\n┃\n┃\n┃",
+		},
+		{
+			height:   4,
+			total:    8,
+			offset:   0,
+			expected: "┃\n┃\n│\n│",
+		},
+		{
+			height:   4,
+			total:    8,
+			offset:   4,
+			expected: "│\n│\n┃\n┃",
+		},
+		{
+			height:   4,
+			total:    100,
+			offset:   50,
+			expected: "│\n│\n┃\n│",
+		},
+		{
+			height:   0,
+			total:    10,
+			offset:   0,
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		result := RenderScrollbar(test.height, test.total, test.offset)
+		if result != test.expected {
+			t.Errorf("RenderScrollbar(%d, %d, %d) = %q; expected %q", test.height, test.total, test.offset, result, test.expected)
+		}
+	}
+}
+
+func TestScrollPercent(t *testing.T) {
+	tests := []struct {
+		height   int
+		total    int
+		offset   int
+		expected string
+	}{
+		{
+			height:   10,
+			total:    5,
+			offset:   0,
+			expected: "100%",
+		},
+		{
+			height:   10,
+			total:    30,
+			offset:   10,
+			expected: "50%",
+		},
+		{
+			height:   10,
+			total:    30,
+			offset:   99,
+			expected: "100%",
+		},
+	}
+
+	for _, test := range tests {
+		result := ScrollPercent(test.height, test.total, test.offset)
+		if result != test.expected {
+			t.Errorf("ScrollPercent(%d, %d, %d) = %q; expected %q", test.height, test.total, test.offset, result, test.expected)
+		}
+	}
+}
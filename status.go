@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Status type represents a semantic state (success, error, warning, info).
+// It is used to render a state consistently, with both a color and a glyph,
+// so that the state is never conveyed by color alone.
+type Status int
+
+// statuses
+const (
+	StatusSuccess Status = iota
+	StatusError
+	StatusWarning
+	StatusInfo
+)
+
+// status glyphs
+var (
+	GlyphSuccess = "✔"
+	GlyphError   = "✘"
+	GlyphWarning = "⚠"
+	GlyphInfo    = "ℹ"
+)
+
+// statusGlyphs tracks whether the glyphs are rendered by RenderStatus.
+var (
+	statusMu     sync.RWMutex
+	statusGlyphs = true
+)
+
+// SetStatusGlyphs function toggles the glyphs rendered by RenderStatus.
+// The glyphs are enabled by default.
+func SetStatusGlyphs(enabled bool) {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	statusGlyphs = enabled
+}
+
+// Color method returns the palette color associated with the status.
+func (s Status) Color() lipgloss.AdaptiveColor {
+	switch s {
+	case StatusSuccess:
+		return CurrentPalette().Success
+	case StatusError:
+		return CurrentPalette().Error
+	case StatusWarning:
+		return CurrentPalette().Warning
+	default:
+		return CurrentPalette().Info
+	}
+}
+
+// Glyph method returns the glyph associated with the status, adapted to the
+// current profile (see AdaptGlyph).
+func (s Status) Glyph() string {
+	switch s {
+	case StatusSuccess:
+		return AdaptGlyph(GlyphSuccess)
+	case StatusError:
+		return AdaptGlyph(GlyphError)
+	case StatusWarning:
+		return AdaptGlyph(GlyphWarning)
+	default:
+		return AdaptGlyph(GlyphInfo)
+	}
+}
+
+// RenderStatus function returns a string styled with the color of the status.
+// It takes a status, a string and a list of style options as input.
+// If the status glyphs are enabled (see SetStatusGlyphs), the glyph of the
+// status is added before the string.
+// The style options are applied after the status color, so they can override it.
+func RenderStatus(status Status, text string, options ...StyleOption) string {
+	statusMu.RLock()
+	glyphs := statusGlyphs
+	statusMu.RUnlock()
+
+	if glyphs {
+		text = status.Glyph() + " " + text
+	}
+
+	return Render(text, append([]StyleOption{func(s lipgloss.Style) lipgloss.Style {
+		return s.Foreground(status.Color())
+	}}, options...)...)
+}
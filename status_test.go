@@ -0,0 +1,29 @@
+package tui
+
+import "testing"
+
+func TestRenderStatus(t *testing.T) {
+	defer SetStatusGlyphs(true)
+
+	tests := []struct {
+		status   Status
+		glyphs   bool
+		text     string
+		expected string
+	}{
+		{status: StatusSuccess, glyphs: true, text: "done", expected: "✔ done"},
+		{status: StatusError, glyphs: true, text: "failed", expected: "✘ failed"},
+		{status: StatusWarning, glyphs: true, text: "slow", expected: "⚠ slow"},
+		{status: StatusInfo, glyphs: true, text: "note", expected: "ℹ note"},
+		{status: StatusSuccess, glyphs: false, text: "done", expected: "done"},
+		{status: StatusError, glyphs: false, text: "failed", expected: "failed"},
+	}
+
+	for _, test := range tests {
+		SetStatusGlyphs(test.glyphs)
+		result := RenderStatus(test.status, test.text)
+		if result != test.expected {
+			t.Errorf("RenderStatus(%d, %q) with glyphs %v = %q; expected %q", test.status, test.text, test.glyphs, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Format type represents an export format of a table.
+type Format int
+
+// export formats
+const (
+	CSV Format = iota
+	TSV
+	Markdown // GitHub flavored Markdown
+)
+
+// Export method writes the table to the writer in the provided format.
+// Only the visible columns are exported, in their current order (see Columns).
+// The cells are exported without styles and without truncation.
+func (t *Table) Export(w io.Writer, format Format) error {
+	rows := make([]int, len(t.rows))
+	for i := range rows {
+		rows[i] = i
+	}
+
+	return t.export(w, format, rows)
+}
+
+// ExportSelected method writes the heading and the selected rows of the
+// table to the writer in the provided format (see Export and Select).
+func (t *Table) ExportSelected(w io.Writer, format Format) error {
+	return t.export(w, format, t.Selected())
+}
+
+// export method writes the heading and the rows with the given indexes.
+func (t *Table) export(w io.Writer, format Format, rows []int) error {
+	columns := t.visible()
+	records := make([][]string, 0, len(rows)+1)
+
+	heading := make([]string, len(columns))
+	for j, i := range columns {
+		heading[j] = ansi.Strip(t.columns[i].Title)
+	}
+	records = append(records, heading)
+
+	for _, r := range rows {
+		record := make([]string, len(columns))
+		for j, i := range columns {
+			record[j] = ansi.Strip(t.rows[r][i])
+		}
+		records = append(records, record)
+	}
+
+	switch format {
+	case CSV, TSV:
+		cw := csv.NewWriter(w)
+		if format == TSV {
+			cw.Comma = '\t'
+		}
+		return cw.WriteAll(records)
+	case Markdown:
+		return t.exportMarkdown(w, columns, records)
+	default:
+		return fmt.Errorf("table: unknown export format %d", format)
+	}
+}
+
+// exportMarkdown method writes the records as a GitHub flavored Markdown table.
+// The first record is the heading.
+func (t *Table) exportMarkdown(w io.Writer, columns []int, records [][]string) error {
+	var b strings.Builder
+	for r, record := range records {
+		b.WriteString("|")
+		for _, cell := range record {
+			b.WriteString(" " + strings.ReplaceAll(cell, "|", `\|`) + " |")
+		}
+		b.WriteString("\n")
+
+		if r == 0 {
+			b.WriteString("|")
+			for _, i := range columns {
+				switch t.columns[i].Align {
+				case lipgloss.Center:
+					b.WriteString(" :---: |")
+				case lipgloss.Right:
+					b.WriteString(" ---: |")
+				default:
+					b.WriteString(" --- |")
+				}
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
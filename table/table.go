@@ -0,0 +1,265 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/opts"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Column type describes a column of a table.
+type Column struct {
+	Title string            // the title rendered in the heading row
+	Width int               // the width of the column; 0 means the width of the widest cell
+	Align lipgloss.Position // the horizontal alignment of the cells (lipgloss.Left, lipgloss.Center, lipgloss.Right)
+}
+
+// Table type represents a table with a heading row and a list of rows.
+// Cells longer than the width of their column are truncated.
+// A Table is rendered with the String method.
+type Table struct {
+	columns     []Column
+	order       []int
+	rows        [][]string
+	selected    map[int]bool
+	zebra       bool
+	gap         int // less than 0 until Gap is called: the gap follows the density
+	headingRow  []tui.StyleOption
+	row         []tui.StyleOption
+	oddRow      []tui.StyleOption
+	selectedRow []tui.StyleOption
+	headingRule bool
+}
+
+// New function returns a new table with the given columns.
+// By default the table has a gap of 2 columns between cells (adjusted to
+// the density of the theme when the table is rendered, see tui.Spacing), a
+// rule under the heading row and no zebra striping.
+func New(columns ...Column) *Table {
+	return &Table{
+		columns:     columns,
+		rows:        make([][]string, 0),
+		selected:    make(map[int]bool),
+		gap:         -1,
+		headingRow:  []tui.StyleOption{opts.Bright, opts.Bold},
+		row:         []tui.StyleOption{opts.Bright},
+		oddRow:      []tui.StyleOption{opts.LightMuted},
+		selectedRow: []tui.StyleOption{opts.Accent},
+		headingRule: true,
+	}
+}
+
+// AddRow method adds a row to the table.
+// It takes a list of cells as input: missing cells are rendered empty and
+// cells exceeding the number of columns are ignored.
+// Newlines in the cells are replaced by spaces.
+func (t *Table) AddRow(cells ...string) *Table {
+	row := make([]string, len(t.columns))
+	for i := range row {
+		if i < len(cells) {
+			row[i] = strings.ReplaceAll(cells[i], "\n", " ")
+		}
+	}
+
+	t.rows = append(t.rows, row)
+	return t
+}
+
+// Columns method sets the visible columns and their order.
+// It takes the indexes of the columns (as passed to New) as input; indexes
+// out of range are ignored. Without arguments all the columns are visible
+// in their original order.
+func (t *Table) Columns(order ...int) *Table {
+	t.order = make([]int, 0, len(order))
+	for _, i := range order {
+		if i >= 0 && i < len(t.columns) {
+			t.order = append(t.order, i)
+		}
+	}
+	if len(order) == 0 {
+		t.order = nil
+	}
+
+	return t
+}
+
+// Select method marks the rows with the given indexes as selected.
+// Selected rows are rendered with the selected row style (see SelectedRowStyle)
+// and can be exported on their own (see ExportSelected).
+func (t *Table) Select(rows ...int) *Table {
+	for _, i := range rows {
+		if i >= 0 && i < len(t.rows) {
+			t.selected[i] = true
+		}
+	}
+	return t
+}
+
+// Deselect method removes the rows with the given indexes from the selection.
+// Without arguments the whole selection is cleared.
+func (t *Table) Deselect(rows ...int) *Table {
+	if len(rows) == 0 {
+		t.selected = make(map[int]bool)
+	}
+	for _, i := range rows {
+		delete(t.selected, i)
+	}
+	return t
+}
+
+// Selected method returns the indexes of the selected rows, in ascending order.
+func (t *Table) Selected() []int {
+	selected := make([]int, 0, len(t.selected))
+	for i := range t.rows {
+		if t.selected[i] {
+			selected = append(selected, i)
+		}
+	}
+	return selected
+}
+
+// Zebra method toggles the zebra striping: when enabled, the odd rows are
+// rendered with the odd row style (see OddRowStyle).
+func (t *Table) Zebra(enabled bool) *Table {
+	t.zebra = enabled
+	return t
+}
+
+// Gap method sets the number of blank columns between two cells.
+// If the gap is less than 0, it sets the gap to 0.
+func (t *Table) Gap(gap int) *Table {
+	t.gap = max(gap, 0)
+	return t
+}
+
+// HeadingRule method toggles the rule rendered under the heading row.
+func (t *Table) HeadingRule(enabled bool) *Table {
+	t.headingRule = enabled
+	return t
+}
+
+// HeadingRowStyle method sets the style options applied to the heading cells.
+func (t *Table) HeadingRowStyle(options ...tui.StyleOption) *Table {
+	t.headingRow = options
+	return t
+}
+
+// RowStyle method sets the style options applied to the cells of the rows.
+func (t *Table) RowStyle(options ...tui.StyleOption) *Table {
+	t.row = options
+	return t
+}
+
+// OddRowStyle method sets the style options applied to the cells of the odd
+// rows when the zebra striping is enabled.
+func (t *Table) OddRowStyle(options ...tui.StyleOption) *Table {
+	t.oddRow = options
+	return t
+}
+
+// SelectedRowStyle method sets the style options applied to the cells of the selected rows.
+func (t *Table) SelectedRowStyle(options ...tui.StyleOption) *Table {
+	t.selectedRow = options
+	return t
+}
+
+// visible method returns the indexes of the visible columns, in order.
+func (t *Table) visible() []int {
+	if t.order != nil {
+		return t.order
+	}
+
+	order := make([]int, len(t.columns))
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
+// Widths method returns the rendered width of each column.
+func (t *Table) Widths() []int {
+	widths := make([]int, len(t.columns))
+	for i, c := range t.columns {
+		if c.Width > 0 {
+			widths[i] = c.Width
+			continue
+		}
+
+		widths[i] = lipgloss.Width(c.Title)
+		for _, row := range t.rows {
+			widths[i] = max(widths[i], lipgloss.Width(row[i]))
+		}
+	}
+
+	return widths
+}
+
+// String method renders the table.
+func (t *Table) String() string {
+	columns := t.visible()
+	if len(columns) == 0 {
+		return ""
+	}
+
+	widths := t.Widths()
+	lines := make([]string, 0, len(t.rows)+2)
+
+	titles := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		titles[i] = c.Title
+	}
+	lines = append(lines, t.renderRow(titles, columns, widths, t.headingRow))
+
+	if t.headingRule {
+		total := t.gapWidth() * (len(columns) - 1)
+		for _, i := range columns {
+			total += widths[i]
+		}
+		lines = append(lines, tui.Render(strings.Repeat(tui.AdaptGlyph(tui.GlyphRule), total), opts.Muted))
+	}
+
+	for i, row := range t.rows {
+		options := t.row
+		if t.zebra && i%2 == 1 {
+			options = t.oddRow
+		}
+		if t.selected[i] {
+			options = t.selectedRow
+		}
+		lines = append(lines, t.renderRow(row, columns, widths, options))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderRow method renders the visible cells of a single row.
+func (t *Table) renderRow(cells []string, columns, widths []int, options []tui.StyleOption) string {
+	rendered := make([]string, len(columns))
+	for j, i := range columns {
+		cell := tui.TruncateString(cells[i], widths[i])
+		rendered[j] = tui.Render(cell, append([]tui.StyleOption{
+			opts.Width(widths[i]),
+			opts.Inline,
+			align(t.columns[i].Align),
+		}, options...)...)
+	}
+
+	return strings.Join(rendered, strings.Repeat(" ", t.gapWidth()))
+}
+
+// gapWidth method returns the number of blank columns between two cells:
+// the gap set with Gap or, by default, 2 adjusted to the current density.
+func (t *Table) gapWidth() int {
+	if t.gap < 0 {
+		return tui.Spacing(2)
+	}
+	return t.gap
+}
+
+// align function returns a style option that aligns the text horizontally.
+func align(p lipgloss.Position) tui.StyleOption {
+	return func(s lipgloss.Style) lipgloss.Style {
+		return s.AlignHorizontal(p)
+	}
+}
@@ -0,0 +1,109 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/Tagliapietra96/tui/tuitest"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestMain(m *testing.M) {
+	tuitest.Main(m)
+}
+
+func TestTableString(t *testing.T) {
+	tests := []struct {
+		table    *Table
+		expected string
+	}{
+		{
+			table:    New(),
+			expected: "",
+		},
+		{
+			table:    New(Column{Title: "Name"}, Column{Title: "Qty", Align: lipgloss.Right}).AddRow("apple", "3").AddRow("kiwi", "12"),
+			expected: "Name   Qty\n──────────\napple    3\nkiwi    12",
+		},
+		{
+			table:    New(Column{Title: "Desc", Width: 8}).AddRow("a very long description").HeadingRule(false),
+			expected: "Desc    \na ver...",
+		},
+		{
+			table:    New(Column{Title: "A"}, Column{Title: "B"}).AddRow("1").Gap(1).HeadingRule(false),
+			expected: "A B\n1  ",
+		},
+	}
+
+	for _, test := range tests {
+		result := test.table.String()
+		if result != test.expected {
+			t.Errorf("Table.String() = %q; expected %q", result, test.expected)
+		}
+	}
+}
+
+func TestTableDensity(t *testing.T) {
+	defer tui.SetDensity(tui.DensityNormal)
+
+	table := New(Column{Title: "A"}, Column{Title: "B"}).HeadingRule(false)
+	fixed := New(Column{Title: "A"}, Column{Title: "B"}).HeadingRule(false).Gap(2)
+	tui.SetDensity(tui.DensityCompact)
+	if result := table.String(); result != "A B" {
+		t.Errorf("Table.String() after SetDensity(DensityCompact) = %q; expected %q", result, "A B")
+	}
+	if result := fixed.String(); result != "A  B" {
+		t.Errorf("Table.String() with Gap(2) after SetDensity(DensityCompact) = %q; expected %q", result, "A  B")
+	}
+}
+
+func TestTableExport(t *testing.T) {
+	newTable := func() *Table {
+		return New(Column{Title: "Name"}, Column{Title: "Qty", Align: lipgloss.Right}, Column{Title: "Note"}).
+			AddRow("apple", "3", "red, sweet").
+			AddRow("kiwi", "12", "a|b")
+	}
+
+	tests := []struct {
+		table    *Table
+		format   Format
+		selected bool
+		expected string
+	}{
+		{
+			table:    newTable(),
+			format:   CSV,
+			expected: "Name,Qty,Note\napple,3,\"red, sweet\"\nkiwi,12,a|b\n",
+		},
+		{
+			table:    newTable().Columns(1, 0),
+			format:   TSV,
+			expected: "Qty\tName\n3\tapple\n12\tkiwi\n",
+		},
+		{
+			table:    newTable(),
+			format:   Markdown,
+			expected: "| Name | Qty | Note |\n| --- | ---: | --- |\n| apple | 3 | red, sweet |\n| kiwi | 12 | a\\|b |\n",
+		},
+		{
+			table:    newTable().Columns(0).Select(1),
+			format:   CSV,
+			selected: true,
+			expected: "Name\nkiwi\n",
+		},
+	}
+
+	for _, test := range tests {
+		var b strings.Builder
+		var err error
+		if test.selected {
+			err = test.table.ExportSelected(&b, test.format)
+		} else {
+			err = test.table.Export(&b, test.format)
+		}
+		if err != nil || b.String() != test.expected {
+			t.Errorf("Table.Export(%d) = %q, %v; expected %q, nil", test.format, b.String(), err, test.expected)
+		}
+	}
+}
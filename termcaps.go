@@ -0,0 +1,245 @@
+package tui
+
+import (
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+	"github.com/muesli/termenv"
+)
+
+// ColorDepth type represents the number of colors supported by the terminal.
+type ColorDepth int
+
+// color depths
+const (
+	NoColor   ColorDepth = iota // no colors (e.g. output piped to a file)
+	ANSI16                      // the 16 basic ANSI colors
+	ANSI256                     // the 256 ANSI colors
+	TrueColor                   // 24-bit colors
+)
+
+// Profile type describes the capabilities of the terminal.
+type Profile struct {
+	Colors     ColorDepth // the colors supported by the terminal
+	Unicode    bool       // true if the terminal can render Unicode glyphs and box-drawing runes
+	Hyperlinks bool       // true if the terminal supports OSC 8 hyperlinks
+}
+
+// glyphs
+// They can be customized. The default glyphs are rendered with their ASCII
+// equivalents when the profile does not support Unicode (see AdaptGlyph).
+var (
+	GlyphBullet = "•"
+	GlyphArrow  = "→"
+	GlyphRule   = "─"
+	GlyphDot    = "·"
+)
+
+// terminal profile
+var (
+	profileMu sync.RWMutex
+	profile   Profile
+)
+
+func init() {
+	profileMu.Lock()
+	defer profileMu.Unlock()
+	adaptProfile(os.Stdout)
+}
+
+// adaptProfile function sets the profile detected for a writer and enables
+// the plain mode if the writer is not a terminal. The plain mode enabled
+// with SetPlain is kept. It must be called with profileMu locked.
+func adaptProfile(w io.Writer) {
+	p := detectProfile(w)
+	plain = plainForced || !isTerminal(w)
+	if plain {
+		plainBackup = p
+		p = Profile{}
+	}
+	setProfile(p)
+}
+
+// DetectProfile function detects the capabilities of the terminal attached to stdout.
+// The color depth is detected by lipgloss, the Unicode support from the
+// locale environment variables and the hyperlink support from the
+// environment variables set by the terminal emulators.
+// The profile is detected even if stdout is not a terminal: the package
+// starts in plain mode in that case (see SetPlain).
+func DetectProfile() Profile {
+	return detectProfile(os.Stdout)
+}
+
+// detectProfile function detects the capabilities of the terminal a writer
+// is attached to (see DetectProfile).
+func detectProfile(w io.Writer) Profile {
+	p := Profile{Unicode: detectUnicode(), Hyperlinks: isTerminal(w) && detectHyperlinks()}
+	switch lipgloss.NewRenderer(w).ColorProfile() {
+	case termenv.TrueColor:
+		p.Colors = TrueColor
+	case termenv.ANSI256:
+		p.Colors = ANSI256
+	case termenv.ANSI:
+		p.Colors = ANSI16
+	default:
+		p.Colors = NoColor
+	}
+
+	return p
+}
+
+// SetProfile function forces the capabilities of the terminal, instead of
+// the detected ones (useful for tests or when the output is piped).
+// The colors of the theme are downgraded to the color depth of the profile,
+// and the glyphs and borders are replaced with ASCII equivalents if the
+// profile does not support Unicode.
+func SetProfile(p Profile) {
+	profileMu.Lock()
+	defer profileMu.Unlock()
+	setProfile(p)
+}
+
+// setProfile function sets the profile and the matching lipgloss color
+// profile. It must be called with profileMu locked.
+func setProfile(p Profile) {
+	profile = p
+	switch p.Colors {
+	case TrueColor:
+		lipgloss.SetColorProfile(termenv.TrueColor)
+	case ANSI256:
+		lipgloss.SetColorProfile(termenv.ANSI256)
+	case ANSI16:
+		lipgloss.SetColorProfile(termenv.ANSI)
+	default:
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+}
+
+// CurrentProfile function returns the capabilities of the terminal, as
+// detected or as set by SetProfile.
+func CurrentProfile() Profile {
+	profileMu.RLock()
+	defer profileMu.RUnlock()
+	return profile
+}
+
+// asciiBorder is the border used instead of the box-drawing borders when
+// the profile does not support Unicode.
+var asciiBorder = lipgloss.Border{
+	Top:          "-",
+	Bottom:       "-",
+	Left:         "|",
+	Right:        "|",
+	TopLeft:      "+",
+	TopRight:     "+",
+	BottomLeft:   "+",
+	BottomRight:  "+",
+	MiddleLeft:   "+",
+	MiddleRight:  "+",
+	Middle:       "+",
+	MiddleTop:    "+",
+	MiddleBottom: "+",
+}
+
+// AdaptBorder function returns the border to use with the current profile.
+// It takes a lipgloss border as input and returns it as is if the profile
+// supports Unicode, or an ASCII border ("+", "-", "|") otherwise.
+// Hidden borders are always returned as is.
+func AdaptBorder(b lipgloss.Border) lipgloss.Border {
+	if CurrentProfile().Unicode || b == lipgloss.HiddenBorder() {
+		return b
+	}
+
+	return asciiBorder
+}
+
+// asciiGlyphs maps the default glyphs of the package to the ASCII
+// equivalents used when the profile does not support Unicode.
+var asciiGlyphs = map[string]string{
+	"✔": "v", "✘": "x", "⚠": "!", "ℹ": "i", // status glyphs
+	"•": "*", "→": "->", "─": "-", "·": "-", // Glyph* variables
+	"★": "*", "⯪": "+", "☆": ".", // rating symbols
+	"│": "|", "┃": "#", // scrollbar symbols
+	"■": "#", "█": "#", // LegendSwatch and BannerBlock
+	"░": "-", // progress bars
+}
+
+// AdaptGlyph function returns the glyph to use with the current profile.
+// It takes a glyph as input and returns it as is if the profile supports
+// Unicode. Otherwise the default glyphs of the package (e.g. GlyphBullet)
+// are replaced with their ASCII equivalents, while customized glyphs are
+// returned as is.
+// The glyph variables are never modified, so they can be customized at any
+// time without being reset by SetProfile or SetPlain.
+func AdaptGlyph(g string) string {
+	if CurrentProfile().Unicode {
+		return g
+	}
+	if a, ok := asciiGlyphs[g]; ok {
+		return a
+	}
+
+	return g
+}
+
+// treeGlyphs function returns the branch, last branch and continuation glyphs
+// used to render trees with the current profile.
+func treeGlyphs() (string, string, string) {
+	if CurrentProfile().Unicode {
+		return "├─ ", "└─ ", "│  "
+	}
+	return "|- ", "`- ", "|  "
+}
+
+// detectUnicode function reports whether the terminal is expected to render Unicode.
+// The first locale variable set among LC_ALL, LC_CTYPE and LANG must
+// declare the UTF-8 encoding. Without locale variables, only the Linux
+// console and dumb terminals are considered not to support Unicode.
+func detectUnicode() bool {
+	if runtime.GOOS == "windows" {
+		return true
+	}
+
+	for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := strings.ToLower(os.Getenv(env)); v != "" {
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+
+	t := os.Getenv("TERM")
+	return t != "linux" && t != "dumb"
+}
+
+// isTerminal function reports whether a writer is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	return ok && term.IsTerminal(f.Fd())
+}
+
+// detectHyperlinks function reports whether the terminal is known to support
+// OSC 8 hyperlinks, based on the environment variables set by the terminal emulators.
+func detectHyperlinks() bool {
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty":
+		return true
+	}
+
+	for _, env := range []string{"WT_SESSION", "KITTY_WINDOW_ID", "KONSOLE_VERSION", "ALACRITTY_WINDOW_ID"} {
+		if os.Getenv(env) != "" {
+			return true
+		}
+	}
+
+	if v, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && v >= 5000 {
+		return true
+	}
+
+	t := os.Getenv("TERM")
+	return strings.Contains(t, "kitty") || strings.Contains(t, "foot") || strings.Contains(t, "alacritty")
+}
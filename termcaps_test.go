@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSetProfile(t *testing.T) {
+	defer SetProfile(Profile{Colors: NoColor, Unicode: true})
+
+	SetProfile(Profile{Colors: NoColor, Unicode: false})
+	if AdaptGlyph(GlyphSuccess) != "v" || AdaptGlyph(GlyphRule) != "-" {
+		t.Errorf("AdaptGlyph without Unicode = %q and %q; expected %q and %q", AdaptGlyph(GlyphSuccess), AdaptGlyph(GlyphRule), "v", "-")
+	}
+	if b := AdaptBorder(lipgloss.RoundedBorder()); b != asciiBorder {
+		t.Errorf("AdaptBorder(RoundedBorder) without Unicode = %v; expected the ASCII border", b)
+	}
+	if result := RenderError(fmt.Errorf("a: %w", fmt.Errorf("b"))); result != "x a\n  `- b" {
+		t.Errorf("RenderError without Unicode = %q; expected %q", result, "x a\n  `- b")
+	}
+
+	SetProfile(Profile{Colors: NoColor, Unicode: true})
+	if AdaptGlyph(GlyphSuccess) != "✔" || AdaptBorder(lipgloss.RoundedBorder()) != lipgloss.RoundedBorder() {
+		t.Errorf("SetProfile with Unicode did not restore the Unicode glyphs and borders")
+	}
+}
+
+func TestAdaptGlyph(t *testing.T) {
+	defer func(bullet string) {
+		GlyphBullet = bullet
+		SetProfile(Profile{Colors: NoColor, Unicode: true})
+	}(GlyphBullet)
+
+	GlyphBullet = "▸"
+	SetProfile(Profile{Colors: NoColor, Unicode: false})
+	SetProfile(Profile{Colors: NoColor, Unicode: true})
+	if GlyphBullet != "▸" {
+		t.Errorf("SetProfile reset the customized GlyphBullet to %q; expected %q", GlyphBullet, "▸")
+	}
+
+	tests := []struct {
+		unicode  bool
+		glyph    string
+		expected string
+	}{
+		{unicode: true, glyph: GlyphArrow, expected: "→"},
+		{unicode: false, glyph: GlyphArrow, expected: "->"},
+		{unicode: false, glyph: RatingFull, expected: "*"},
+		{unicode: false, glyph: ScrollbarThumb, expected: "#"},
+		{unicode: false, glyph: "▸", expected: "▸"},
+		{unicode: false, glyph: ">", expected: ">"},
+	}
+
+	for _, test := range tests {
+		SetProfile(Profile{Colors: NoColor, Unicode: test.unicode})
+		result := AdaptGlyph(test.glyph)
+		if result != test.expected {
+			t.Errorf("AdaptGlyph(%q) with Unicode %v = %q; expected %q", test.glyph, test.unicode, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// Density type represents how much space the components leave around their content.
+type Density int
+
+// densities
+const (
+	DensityNormal      Density = iota
+	DensityCompact             // less spacing, for small terminals or power users
+	DensityComfortable         // more spacing, for presentations
+)
+
+// Theme type holds the full palette and the density used by the package.
+// A theme is applied with SetTheme (or UseTheme for registered themes),
+// which updates the palette used by the style options (see CurrentPalette).
+type Theme struct {
+	Name       string
+	Density    Density
+	Accent     lipgloss.AdaptiveColor
+	Bright     lipgloss.AdaptiveColor
+	Muted      lipgloss.AdaptiveColor
+	LightMuted lipgloss.AdaptiveColor
+	Error      lipgloss.AdaptiveColor
+	Success    lipgloss.AdaptiveColor
+	Warning    lipgloss.AdaptiveColor
+	Info       lipgloss.AdaptiveColor
+	Link       lipgloss.AdaptiveColor
+}
+
+// built-in themes
+var (
+	// DefaultTheme adapts its colors to the background of the terminal.
+	DefaultTheme = Theme{
+		Name:       "default",
+		Accent:     ColorAccent,
+		Bright:     ColorBright,
+		Muted:      ColorMuted,
+		LightMuted: ColorLightMuted,
+		Error:      ColorError,
+		Success:    ColorSuccess,
+		Warning:    ColorWarning,
+		Info:       ColorInfo,
+		Link:       ColorLink,
+	}
+
+	// LightTheme always uses the light variant of the default colors.
+	LightTheme = DefaultTheme.fixed("light", false)
+
+	// DarkTheme always uses the dark variant of the default colors.
+	DarkTheme = DefaultTheme.fixed("dark", true)
+
+	// HighContrastTheme remaps the default colors to reach the ContrastAAA ratio.
+	HighContrastTheme = DefaultTheme.remap("high-contrast", highContrastColor)
+
+	// ColorBlindTheme uses the color-blind safe palette for the accent and status colors.
+	ColorBlindTheme = DefaultTheme.remap("color-blind", colorBlindColor)
+)
+
+// theme state
+// The palette (the theme with the palette modes applied) is swapped
+// atomically, so that it can be read while rendering without taking themeMu.
+// The changes and their notifications are serialized by themeNotifyMu.
+var (
+	themeMu        sync.RWMutex
+	themeNotifyMu  sync.Mutex
+	theme          = DefaultTheme
+	currentPalette atomic.Pointer[Theme]
+	themeChanged   = NewValue(DefaultTheme)
+	themes         = map[string]Theme{
+		DefaultTheme.Name:      DefaultTheme,
+		LightTheme.Name:        LightTheme,
+		DarkTheme.Name:         DarkTheme,
+		HighContrastTheme.Name: HighContrastTheme,
+		ColorBlindTheme.Name:   ColorBlindTheme,
+	}
+)
+
+// SetTheme function sets the current theme.
+// It takes a theme as input and swaps the whole palette at once (see CurrentPalette).
+// The high contrast and color-blind safe modes, if enabled, are applied on
+// top of the new theme.
+func SetTheme(t Theme) {
+	changeTheme(func() {
+		theme = t
+	})
+}
+
+// OnThemeChange function registers a function called with the new theme
+// every time the theme, the density or a palette mode changes (see SetTheme,
+// SetDensity, SetHighContrast and SetColorBlindSafe), so that the views
+// rendered with the previous colors can be rendered again.
+// The function is called after the palette colors are updated, without
+// holding the theme lock, so it can read the theme or render. The
+// notifications are delivered in the order of the changes, so the function
+// must not change the theme itself (it would wait for its own notification).
+// It returns a function that removes the subscription.
+func OnThemeChange(f func(Theme)) func() {
+	return themeChanged.Subscribe(f)
+}
+
+// ThemeDependency function returns a dependency that changes every time the
+// theme changes (see OnThemeChange), so that a Computed text is rendered
+// again with the new colors.
+func ThemeDependency() Dependency {
+	return themeChanged
+}
+
+// CurrentTheme function returns the current theme, as set by SetTheme.
+func CurrentTheme() Theme {
+	themeMu.RLock()
+	defer themeMu.RUnlock()
+	return theme
+}
+
+// CurrentPalette function returns the colors used by the package to render:
+// the current theme with the high contrast and color-blind safe modes
+// applied (see SetHighContrast and SetColorBlindSafe).
+// The palette is swapped atomically on every theme change, so it is safe to
+// call it while another goroutine changes the theme.
+func CurrentPalette() Theme {
+	if p := currentPalette.Load(); p != nil {
+		return *p
+	}
+	return DefaultTheme
+}
+
+// RegisterTheme function adds a theme to the registry, so that it can be
+// selected by name with UseTheme.
+// A theme with the same name is replaced.
+func RegisterTheme(t Theme) {
+	themeMu.Lock()
+	defer themeMu.Unlock()
+	themes[t.Name] = t
+}
+
+// UseTheme function sets the current theme to the registered theme with the given name.
+// It returns an error if no theme with that name is registered.
+func UseTheme(name string) error {
+	themeMu.RLock()
+	t, ok := themes[name]
+	themeMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("theme %q is not registered", name)
+	}
+
+	SetTheme(t)
+	return nil
+}
+
+// Themes function returns the names of the registered themes, sorted alphabetically.
+func Themes() []string {
+	themeMu.RLock()
+	defer themeMu.RUnlock()
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// NewThemeFromAccent function derives a full theme from a single accent color.
+// It takes the accent color (a hex value or an ANSI code) as input.
+// The bright and muted colors are neutrals tinted with the hue of the accent,
+// the error, success and warning colors keep their usual hues with the
+// saturation of the accent, and the info and link colors use the
+// complementary hue. Every color is adjusted to reach the ContrastAA ratio
+// against its reference background (see EnsureContrast).
+// The theme is named after the accent color (e.g. "accent-#1e90ff").
+// If the color cannot be parsed, it returns a copy of DefaultTheme.
+func NewThemeFromAccent(c lipgloss.Color) Theme {
+	rgb, ok := colorToRGB(c)
+	if !ok {
+		return DefaultTheme
+	}
+
+	h, s, _ := rgb.Hsl()
+	s = max(s, 0.5)
+	return Theme{
+		Name:       "accent-" + rgb.Hex(),
+		Accent:     adaptiveColor(rgb.Hex(), rgb.Hex()),
+		Bright:     adaptiveColor(hslHex(h, 0.1, 0.12), hslHex(h, 0.1, 0.95)),
+		Muted:      adaptiveColor(hslHex(h, 0.08, 0.45), hslHex(h, 0.08, 0.55)),
+		LightMuted: adaptiveColor(hslHex(h, 0.08, 0.35), hslHex(h, 0.08, 0.7)),
+		Error:      adaptiveColor(hslHex(0, s, 0.4), hslHex(0, s, 0.6)),
+		Success:    adaptiveColor(hslHex(130, s, 0.3), hslHex(130, s, 0.5)),
+		Warning:    adaptiveColor(hslHex(35, s, 0.4), hslHex(35, s, 0.55)),
+		Info:       adaptiveColor(hslHex(h+180, s, 0.35), hslHex(h+180, s, 0.65)),
+		Link:       adaptiveColor(hslHex(h+180, s, 0.3), hslHex(h+180, s, 0.6)),
+	}
+}
+
+// hslHex function returns the hex value of a color given its hue (in
+// degrees, wrapped into [0, 360)), saturation and lightness.
+func hslHex(h, s, l float64) string {
+	return colorful.Hsl(math.Mod(h+360, 360), s, l).Clamped().Hex()
+}
+
+// adaptiveColor function returns an adaptive color whose light and dark
+// variants reach the ContrastAA ratio against their reference backgrounds.
+func adaptiveColor(light, dark string) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{
+		Light: string(EnsureContrast(lipgloss.Color(light), lightBackground, ContrastAA)),
+		Dark:  string(EnsureContrast(lipgloss.Color(dark), darkBackground, ContrastAA)),
+	}
+}
+
+// SetDensity function sets the density of the current theme.
+// The density adjusts the default paddings and margins of the components
+// (see Spacing).
+func SetDensity(d Density) {
+	changeTheme(func() {
+		theme.Density = d
+	})
+}
+
+// Spacing function adjusts a default spacing (padding, margin or gap) to
+// the density of the current theme.
+// It takes the spacing used with the normal density as input and returns it
+// decreased by one with the compact density and increased by one with the
+// comfortable density. A spacing of 0 is never changed.
+func Spacing(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	switch CurrentTheme().Density {
+	case DensityCompact:
+		return n - 1
+	case DensityComfortable:
+		return n + 1
+	default:
+		return n
+	}
+}
+
+// palette method returns the colors of the theme with their names.
+// The colors are returned as pointers so that they can be remapped in place.
+func (t *Theme) palette() []namedColor {
+	return []namedColor{
+		{"Accent", &t.Accent},
+		{"Bright", &t.Bright},
+		{"Muted", &t.Muted},
+		{"LightMuted", &t.LightMuted},
+		{"Error", &t.Error},
+		{"Success", &t.Success},
+		{"Warning", &t.Warning},
+		{"Info", &t.Info},
+		{"Link", &t.Link},
+	}
+}
+
+// remap method returns a copy of the theme with the given name and every
+// color transformed by the function.
+func (t Theme) remap(name string, f func(name string, c lipgloss.AdaptiveColor) lipgloss.AdaptiveColor) Theme {
+	t.Name = name
+	for _, c := range t.palette() {
+		*c.color = f(c.name, *c.color)
+	}
+
+	return t
+}
+
+// fixed method returns a copy of the theme with the given name where every
+// color always uses its dark or light variant.
+func (t Theme) fixed(name string, dark bool) Theme {
+	return t.remap(name, func(_ string, c lipgloss.AdaptiveColor) lipgloss.AdaptiveColor {
+		if dark {
+			return lipgloss.AdaptiveColor{Light: c.Dark, Dark: c.Dark}
+		}
+		return lipgloss.AdaptiveColor{Light: c.Light, Dark: c.Light}
+	})
+}
+
+// changeTheme function applies a change to the theme state and updates the
+// palette while holding the lock, then notifies the subscribers
+// registered with OnThemeChange.
+// Concurrent changes are notified in the same order they are applied, so the
+// last notification always carries the current theme.
+func changeTheme(f func()) {
+	themeNotifyMu.Lock()
+	defer themeNotifyMu.Unlock()
+
+	themeMu.Lock()
+	f()
+	updatePalette()
+	t := theme
+	themeMu.Unlock()
+
+	themeChanged.Set(t)
+}
+
+// updatePalette function swaps the palette with a new one built from the
+// current theme and the enabled palette modes. It must be called with
+// themeMu locked.
+func updatePalette() {
+	t := theme
+	if colorBlindSafe {
+		t = t.remap(t.Name, colorBlindColor)
+	}
+	if highContrast {
+		t = t.remap(t.Name, highContrastColor)
+	}
+
+	currentPalette.Store(&t)
+}
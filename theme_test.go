@@ -0,0 +1,185 @@
+package tui
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSetTheme(t *testing.T) {
+	defer SetTheme(DefaultTheme)
+
+	SetTheme(DarkTheme)
+	if CurrentPalette().Accent != DarkTheme.Accent {
+		t.Errorf("SetTheme(DarkTheme) set CurrentPalette().Accent to %v; expected %v", CurrentPalette().Accent, DarkTheme.Accent)
+	}
+	if CurrentTheme().Name != DarkTheme.Name {
+		t.Errorf("CurrentTheme().Name = %q; expected %q", CurrentTheme().Name, DarkTheme.Name)
+	}
+
+	SetHighContrast(true)
+	SetTheme(LightTheme)
+	if CurrentPalette().Muted != highContrastColor("Muted", LightTheme.Muted) {
+		t.Errorf("SetTheme(LightTheme) in high contrast mode set CurrentPalette().Muted to %v; expected %v", CurrentPalette().Muted, highContrastColor("Muted", LightTheme.Muted))
+	}
+	SetHighContrast(false)
+}
+
+func TestUseTheme(t *testing.T) {
+	defer SetTheme(DefaultTheme)
+
+	custom := DefaultTheme
+	custom.Name = "custom"
+	custom.Accent = ColorInfo
+	RegisterTheme(custom)
+
+	if err := UseTheme("custom"); err != nil {
+		t.Errorf("UseTheme(%q) returned error %v", "custom", err)
+	}
+	if CurrentPalette().Accent != custom.Accent {
+		t.Errorf("UseTheme(%q) set CurrentPalette().Accent to %v; expected %v", "custom", CurrentPalette().Accent, custom.Accent)
+	}
+	if err := UseTheme("missing"); err == nil {
+		t.Errorf("UseTheme(%q) returned no error", "missing")
+	}
+}
+
+func TestSpacing(t *testing.T) {
+	defer SetDensity(DensityNormal)
+
+	tests := []struct {
+		density  Density
+		spacing  int
+		expected int
+	}{
+		{
+			density:  DensityNormal,
+			spacing:  2,
+			expected: 2,
+		},
+		{
+			density:  DensityCompact,
+			spacing:  2,
+			expected: 1,
+		},
+		{
+			density:  DensityComfortable,
+			spacing:  2,
+			expected: 3,
+		},
+		{
+			density:  DensityComfortable,
+			spacing:  0,
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		SetDensity(test.density)
+		result := Spacing(test.spacing)
+		if result != test.expected {
+			t.Errorf("Spacing(%d) with density %d = %d; expected %d", test.spacing, test.density, result, test.expected)
+		}
+	}
+}
+
+func TestNewThemeFromAccent(t *testing.T) {
+	th := NewThemeFromAccent("#1e90ff")
+	if th.Name != "accent-#1e90ff" {
+		t.Errorf("NewThemeFromAccent(%q).Name = %q; expected %q", "#1e90ff", th.Name, "accent-#1e90ff")
+	}
+	for _, c := range th.palette() {
+		if r := ContrastRatio(lipgloss.Color(c.color.Light), lightBackground); r < ContrastAA {
+			t.Errorf("NewThemeFromAccent(%q).%s.Light has contrast %.2f; expected at least %.2f", "#1e90ff", c.name, r, ContrastAA)
+		}
+		if r := ContrastRatio(lipgloss.Color(c.color.Dark), darkBackground); r < ContrastAA {
+			t.Errorf("NewThemeFromAccent(%q).%s.Dark has contrast %.2f; expected at least %.2f", "#1e90ff", c.name, r, ContrastAA)
+		}
+	}
+
+	if th := NewThemeFromAccent("not a color"); th.Name != DefaultTheme.Name {
+		t.Errorf("NewThemeFromAccent(%q).Name = %q; expected %q", "not a color", th.Name, DefaultTheme.Name)
+	}
+}
+
+func TestOnThemeChange(t *testing.T) {
+	defer SetTheme(DefaultTheme)
+
+	names := make([]string, 0)
+	unsubscribe := OnThemeChange(func(th Theme) {
+		names = append(names, th.Name+":"+CurrentTheme().Name)
+	})
+	text := NewComputed(func() string { return CurrentPalette().Accent.Dark }, ThemeDependency())
+
+	SetTheme(DarkTheme)
+	if text.String() != DarkTheme.Accent.Dark {
+		t.Errorf("Computed.String() = %q; expected %q", text.String(), DarkTheme.Accent.Dark)
+	}
+	SetTheme(ColorBlindTheme)
+	if text.String() != ColorBlindTheme.Accent.Dark {
+		t.Errorf("Computed.String() = %q; expected %q", text.String(), ColorBlindTheme.Accent.Dark)
+	}
+
+	unsubscribe()
+	SetTheme(LightTheme)
+	if len(names) != 2 || names[0] != "dark:dark" || names[1] != "color-blind:color-blind" {
+		t.Errorf("OnThemeChange received %q; expected %q", names, []string{"dark:dark", "color-blind:color-blind"})
+	}
+}
+
+func TestSetThemeConcurrentNotify(t *testing.T) {
+	defer SetTheme(DefaultTheme)
+
+	var mu sync.Mutex
+	last := ""
+	unsubscribe := OnThemeChange(func(th Theme) {
+		mu.Lock()
+		last = th.Name
+		mu.Unlock()
+	})
+	defer unsubscribe()
+
+	var wg sync.WaitGroup
+	for _, th := range []Theme{DarkTheme, LightTheme, HighContrastTheme, ColorBlindTheme} {
+		wg.Add(1)
+		go func(th Theme) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				SetTheme(th)
+			}
+		}(th)
+	}
+	wg.Wait()
+
+	current := CurrentTheme().Name
+	if last != current || themeChanged.Get().Name != current {
+		t.Errorf("the last notification is %q; expected the current theme %q", last, current)
+	}
+}
+
+func TestSetThemeConcurrentRender(t *testing.T) {
+	defer SetTheme(DefaultTheme)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			SetTheme(DarkTheme)
+			SetColorBlindSafe(i%2 == 0)
+			SetTheme(LightTheme)
+		}
+		SetColorBlindSafe(false)
+	}()
+
+	accent := func(s lipgloss.Style) lipgloss.Style {
+		return s.Foreground(CurrentPalette().Accent)
+	}
+	for i := 0; i < 100; i++ {
+		Render("text", accent)
+		Badge("ok", BadgeSuccess)
+		RenderStatus(StatusInfo, "info")
+		Card("title", "body", "footer", CardAccent())
+	}
+	<-done
+}
@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// SetTitle function sets the title of the terminal window or tab, e.g. to show
+// the progress of a task or the current file.
+// It takes a format and its arguments as input, like fmt.Sprintf.
+// The sequence is written to the output (see SetOutput); in plain mode (see
+// SetPlain) nothing is written.
+func SetTitle(format string, args ...any) error {
+	if IsPlain() {
+		return nil
+	}
+
+	_, err := io.WriteString(Output(), ansi.SetWindowTitle(sanitizeOSC(fmt.Sprintf(format, args...))))
+	return err
+}
+
+// SetTabColor function sets the color of the terminal tab.
+// It takes a color (a hex value or an ANSI code) as input.
+// Only iTerm2 supports the tab color: on other terminals, in plain mode (see
+// SetPlain) or if the color cannot be parsed, nothing is written.
+func SetTabColor(c lipgloss.Color) error {
+	if IsPlain() || os.Getenv("TERM_PROGRAM") != "iTerm.app" {
+		return nil
+	}
+
+	_, err := io.WriteString(Output(), tabColor(c))
+	return err
+}
+
+// ResetTabColor function restores the default color of the terminal tab (see SetTabColor).
+func ResetTabColor() error {
+	if IsPlain() || os.Getenv("TERM_PROGRAM") != "iTerm.app" {
+		return nil
+	}
+
+	_, err := io.WriteString(Output(), "\x1b]6;1;bg;*;default\a")
+	return err
+}
+
+// tabColor function returns the iTerm2 sequences that set the color of the tab.
+// If the color cannot be parsed, it returns an empty string.
+func tabColor(c lipgloss.Color) string {
+	rgb, ok := colorToRGB(c)
+	if !ok {
+		return ""
+	}
+
+	r, g, b := rgb.RGB255()
+	s := ""
+	for _, channel := range []struct {
+		name  string
+		value uint8
+	}{{"red", r}, {"green", g}, {"blue", b}} {
+		s += "\x1b]6;1;bg;" + channel.name + ";brightness;" + strconv.Itoa(int(channel.value)) + "\a"
+	}
+	return s
+}
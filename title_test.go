@@ -0,0 +1,24 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestTabColor(t *testing.T) {
+	tests := []struct {
+		color    lipgloss.Color
+		expected string
+	}{
+		{"#ff8000", "\x1b]6;1;bg;red;brightness;255\a\x1b]6;1;bg;green;brightness;128\a\x1b]6;1;bg;blue;brightness;0\a"},
+		{"not a color", ""},
+	}
+
+	for _, test := range tests {
+		result := tabColor(test.color)
+		if result != test.expected {
+			t.Errorf("tabColor(%q) = %q; expected %q", test.color, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package tuitest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Tagliapietra96/tui"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// UpdateEnv is the environment variable that makes AssertGolden rewrite the
+// golden files instead of comparing them (e.g. TUI_UPDATE_GOLDEN=1 go test ./...).
+const UpdateEnv = "TUI_UPDATE_GOLDEN"
+
+// Main function runs the tests of a package with a fixed profile: no colors
+// and Unicode glyphs, regardless of the environment running the tests.
+// It is meant to be called from TestMain and exits with the result of the tests.
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//		tuitest.Main(m)
+//	}
+func Main(m *testing.M) {
+	tui.SetProfile(tui.Profile{Colors: tui.NoColor, Unicode: true})
+	os.Exit(m.Run())
+}
+
+// Normalize function normalizes a rendered string for comparisons.
+// It removes the ANSI sequences, converts "\r\n" line endings to "\n" and
+// removes the trailing spaces of every line and the trailing empty lines.
+func Normalize(s string) string {
+	lines := strings.Split(strings.ReplaceAll(ansi.Strip(s), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// Fit function renders a string in a fixed size area, as a terminal of that
+// size would show it.
+// It takes a rendered string, a width and a height as input: lines longer
+// than the width are cut, shorter lines are padded with spaces, extra lines
+// are dropped and missing lines are added empty.
+// The ANSI sequences are kept.
+func Fit(s string, width, height int) string {
+	width, height = max(width, 0), max(height, 0)
+	lines := strings.Split(s, "\n")
+	fitted := make([]string, height)
+	for i := range fitted {
+		line := ""
+		if i < len(lines) {
+			line = ansi.Truncate(lines[i], width, "")
+		}
+		fitted[i] = line + strings.Repeat(" ", width-ansi.StringWidth(line))
+	}
+
+	return strings.Join(fitted, "\n")
+}
+
+// AssertGolden function compares a rendered string with a golden file.
+// It takes the test, the name of the golden file and the rendered string as
+// input. The golden file is testdata/<name>.golden, relative to the package
+// of the test. Both contents are normalized (see Normalize) before the
+// comparison; on mismatch the test fails with a line by line diff.
+// When the UpdateEnv environment variable is set, the golden file is written instead.
+func AssertGolden(t testing.TB, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	got = Normalize(got)
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating the golden file directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got+"\n"), 0o644); err != nil {
+			t.Fatalf("writing the golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading the golden file %s: %v (run the tests with %s=1 to create it)", path, err, UpdateEnv)
+	}
+
+	if w := Normalize(string(want)); w != got {
+		t.Errorf("output does not match the golden file %s:\n%s", path, Diff(w, got))
+	}
+}
+
+// Diff function returns a line by line diff between two strings.
+// Lines only in want are prefixed with "- ", lines only in got with "+ "
+// and common lines with two spaces.
+func Diff(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, "  "+a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "- "+a[i])
+			i++
+		default:
+			lines = append(lines, "+ "+b[j])
+			j++
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
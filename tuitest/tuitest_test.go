@@ -0,0 +1,93 @@
+package tuitest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "\x1b[1mbold\x1b[0m  \r\nline  \n\n",
+			expected: "bold\nline",
+		},
+		{
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		result := Normalize(test.input)
+		if result != test.expected {
+			t.Errorf("Normalize(%q) = %q; expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		input    string
+		width    int
+		height   int
+		expected string
+	}{
+		{
+			input:    "hello world\nhi",
+			width:    5,
+			height:   3,
+			expected: "hello\nhi   \n     ",
+		},
+		{
+			input:    "a\nb\nc",
+			width:    1,
+			height:   2,
+			expected: "a\nb",
+		},
+	}
+
+	for _, test := range tests {
+		result := Fit(test.input, test.width, test.height)
+		if result != test.expected {
+			t.Errorf("Fit(%q, %d, %d) = %q; expected %q", test.input, test.width, test.height, result, test.expected)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	result := Diff("a\nb\nc", "a\nx\nc")
+	expected := "  a\n- b\n+ x\n  c"
+	if result != expected {
+		t.Errorf("Diff = %q; expected %q", result, expected)
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	AssertGolden(t, "example", "\x1b[1mgolden\x1b[0m  \nfile")
+}
+
+func TestAssertGoldenUpdate(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(UpdateEnv, "1")
+	AssertGolden(t, "updated", "line  \n\n")
+
+	content, err := os.ReadFile(filepath.Join("testdata", "updated.golden"))
+	if err != nil || string(content) != "line\n" {
+		t.Errorf("AssertGolden with %s set wrote %q, %v; expected %q, nil", UpdateEnv, content, err, "line\n")
+	}
+
+	t.Setenv(UpdateEnv, "")
+	AssertGolden(t, "updated", "line")
+}
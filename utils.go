@@ -2,11 +2,15 @@ package tui
 
 import (
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/term"
+	"github.com/rivo/uniseg"
 )
 
 // FormatIntWithPrefix function formats an integer with a prefix.
@@ -78,59 +82,313 @@ func CleanString(s string) string {
 //
 // Note: The length is neither the number of characters nor the number
 // of bytes in the string. Is the width of the string.
-// The function uses lipgloss.Width to calculate the width of the string
-// and the truncation string.
 // The length of the truncation string is subtracted from the specified length
 // to determine the maximum length of the string.
 // If the length is less than the width of the truncation string, the function
 // returns the truncated string without the truncation string.
 // If the length is less than or equal to 0, the function returns an empty string.
+// The string is never cut in the middle of a multi-byte rune or of an ANSI sequence.
+// See Truncate for more truncation options.
 func TruncateString(str string, length int, truncation ...string) string {
-	// If the length is less than or equal to 0, return an empty string
-	if length <= 0 {
-		return ""
-	}
-
-	// set the truncation string
-	var b strings.Builder
 	tr := "..."
 	if len(truncation) > 0 {
 		tr = truncation[0]
 	}
 
-	// If the width of the string is greater than the specified length
-	// truncate the string and append the truncation string
-	if lipgloss.Width(str) > length {
-		dots := Render(tr, func(s lipgloss.Style) lipgloss.Style {
-			return s.Foreground(ColorMuted)
+	return Truncate(str, length, TruncateTail(tr))
+}
+
+// TruncatePosition type represents where the content of a string is removed by Truncate.
+type TruncatePosition int
+
+// truncate positions
+const (
+	TruncateEnd    TruncatePosition = iota // "Hello, W..."
+	TruncateMiddle                         // "Hell...rld!"
+	TruncateStart                          // "..., World!"
+)
+
+// TruncateOption type is a function that configures the behavior of Truncate.
+type TruncateOption func(*truncateConfig)
+
+// truncateConfig holds the configuration of Truncate.
+type truncateConfig struct {
+	tail     string
+	position TruncatePosition
+	words    bool
+}
+
+// TruncateTail returns a truncate option that sets the string marking the
+// removed content (the default is "...").
+func TruncateTail(tail string) TruncateOption {
+	return func(c *truncateConfig) {
+		c.tail = tail
+	}
+}
+
+// TruncateAt returns a truncate option that sets where the content is removed
+// (the default is TruncateEnd).
+func TruncateAt(position TruncatePosition) TruncateOption {
+	return func(c *truncateConfig) {
+		c.position = position
+	}
+}
+
+// TruncateWords returns a truncate option that cuts the string at a word
+// boundary instead of in the middle of a word. A single word longer than the
+// available width is still cut.
+func TruncateWords() TruncateOption {
+	return func(c *truncateConfig) {
+		c.words = true
+	}
+}
+
+// Truncate function truncates a string to a width.
+// It takes a string, a width and a list of truncate options as input and
+// returns the truncated string, with the tail (default "...") rendered in the
+// muted color where the content was removed.
+// The width is measured in terminal cells: wide runes and ANSI sequences are
+// handled correctly and never cut in half.
+// When the content is removed from the start or the middle of the string, the
+// kept end of the string loses its styles.
+// If the width is less than the width of the tail, the string is truncated
+// without the tail. If the width is less than or equal to 0, it returns an empty string.
+// Example:
+//
+//	Truncate("Hello, World!", 10) => "Hello, ..."
+//	Truncate("Hello, World!", 10, TruncateAt(TruncateStart)) => "... World!"
+//	Truncate("Hello, World!", 10, TruncateWords()) => "Hello,..."
+func Truncate(str string, width int, options ...TruncateOption) string {
+	if width <= 0 {
+		return ""
+	}
+	if ansi.StringWidth(str) <= width {
+		return str
+	}
+
+	config := &truncateConfig{tail: "..."}
+	for _, option := range options {
+		option(config)
+	}
+
+	// If the width is less than the width of the tail
+	// truncate the string without the tail
+	tail := config.tail
+	if ansi.StringWidth(tail) > width {
+		tail = ""
+	}
+	keep := width - ansi.StringWidth(tail)
+	if tail != "" {
+		tail = Render(tail, func(s lipgloss.Style) lipgloss.Style {
+			return s.Foreground(CurrentPalette().Muted).Inline(true)
 		})
+	}
 
-		// If the length is less than the width of the truncation string
-		// return the truncated string without the truncation string
-		if length < lipgloss.Width(dots) {
-			return str[:length]
+	switch config.position {
+	case TruncateStart:
+		return tail + truncateLeft(str, keep, config.words)
+	case TruncateMiddle:
+		right := keep / 2
+		return truncateRight(str, keep-right, config.words) + tail + truncateLeft(str, right, config.words)
+	default:
+		return truncateRight(str, keep, config.words) + tail
+	}
+}
+
+// truncateRight function keeps the first cells of a string, up to the width.
+// If words is true, the string is cut at the end of the last word that fits.
+func truncateRight(str string, width int, words bool) string {
+	if words {
+		// find the width of the longest prefix ending before a whitespace
+		boundary, w := 0, 0
+		g := uniseg.NewGraphemes(ansi.Strip(str))
+		for g.Next() {
+			space := strings.TrimSpace(g.Str()) == ""
+			if space {
+				boundary = w
+			}
+			if w+g.Width() > width {
+				break
+			}
+			w += g.Width()
 		}
+		if boundary > 0 {
+			width = boundary
+		}
+	}
 
-		// Otherwise, truncate the string and append the truncation string
-		b.WriteString(str[:length-lipgloss.Width(dots)])
-		b.WriteString(dots)
-	} else {
-		// if the width of the string is less than or equal to the specified length
-		// return the string as is
-		b.WriteString(str)
+	return ansi.Truncate(str, width, "")
+}
+
+// truncateLeft function keeps the last cells of a string, up to the width.
+// If words is true, the string is cut at the start of the first word that fits.
+// The styles of the string are removed.
+func truncateLeft(str string, width int, words bool) string {
+	clusters := make([]string, 0)
+	widths := make([]int, 0)
+	g := uniseg.NewGraphemes(ansi.Strip(str))
+	for g.Next() {
+		clusters = append(clusters, g.Str())
+		widths = append(widths, g.Width())
 	}
 
-	return b.String()
+	// find the first cluster that fits in the width
+	start, w := len(clusters), 0
+	for start > 0 && w+widths[start-1] <= width {
+		start--
+		w += widths[start]
+	}
+
+	if words {
+		i := start
+		for i < len(clusters) && i > 0 && strings.TrimSpace(clusters[i-1]) != "" {
+			i++
+		}
+		for i < len(clusters) && strings.TrimSpace(clusters[i]) == "" {
+			i++
+		}
+		if i < len(clusters) {
+			start = i
+		}
+	}
+
+	return strings.Join(clusters[start:], "")
+}
+
+// WrapString function wraps a string to a width.
+// It takes a string and a width as input and returns the wrapped string.
+// The string is wrapped at word boundaries; words longer than the width
+// (e.g. urls and paths) are broken so that no line exceeds the width.
+// The function is ANSI-aware: the styles active at the end of a line are
+// closed and opened again at the start of the next one, so every line can
+// be rendered on its own.
+// If the width is less than or equal to 0, the string is returned as is.
+func WrapString(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	return carryStyles(ansi.Wrap(s, width, "-"))
+}
+
+// HardWrap function wraps a string to a width, breaking the lines exactly at
+// the width regardless of word boundaries.
+// Like WrapString, it is ANSI-aware and carries the styles across line breaks.
+// If the width is less than or equal to 0, the string is returned as is.
+func HardWrap(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	return carryStyles(ansi.Hardwrap(s, width, true))
+}
+
+// sgrPattern matches the SGR (style) escape sequences.
+var sgrPattern = regexp.MustCompile(`\x1b\[[0-9;:]*m`)
+
+// carryStyles function closes the styles still active at the end of each
+// line and opens them again at the start of the next line.
+func carryStyles(s string) string {
+	lines := strings.Split(s, "\n")
+	active := make([]string, 0)
+	for i, line := range lines {
+		prefix := strings.Join(active, "")
+		for _, seq := range sgrPattern.FindAllString(line, -1) {
+			params := seq[2 : len(seq)-1]
+			if params == "" || params == "0" || strings.HasPrefix(params, "0;") {
+				active = active[:0]
+			}
+			if params != "" && params != "0" {
+				active = append(active, seq)
+			}
+		}
+
+		lines[i] = prefix + line
+		if len(active) > 0 && i < len(lines)-1 {
+			lines[i] += ansi.ResetStyle
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// terminal size cache
+var (
+	sizeMu       sync.Mutex
+	sizeCached   bool
+	sizeOverride bool
+	sizeWidth    int
+	sizeHeight   int
+)
+
+// SetTerminalSize function overrides the detected size of the terminal.
+// It is useful in tests, SSH sessions and environments where the size of the
+// terminal cannot be detected.
+// If both the width and the height are less than or equal to 0, the override
+// is removed and the size is detected again.
+func SetTerminalSize(width, height int) {
+	sizeMu.Lock()
+	defer sizeMu.Unlock()
+	sizeOverride = width > 0 || height > 0
+	sizeCached = sizeOverride
+	sizeWidth, sizeHeight = max(width, 0), max(height, 0)
+}
+
+// InvalidateTerminalSize function clears the cached size of the terminal, so
+// that it is detected again on the next render.
+// It is called automatically on resize by WatchResize; it has no effect when
+// the size is overridden (see SetTerminalSize).
+func InvalidateTerminalSize() {
+	sizeMu.Lock()
+	defer sizeMu.Unlock()
+	if !sizeOverride {
+		sizeCached = false
+	}
 }
 
 // getTerminalSize function returns the width and height of the terminal.
 // It returns the width and height of the terminal as integers.
-// If the terminal size cannot be determined, it returns 0, 0.
+// The size is detected once and cached until it is invalidated (see
+// InvalidateTerminalSize), unless it is overridden (see SetTerminalSize).
+// If the terminal size cannot be determined, it returns the fallback width
+// (see SetFallbackWidth) and 0.
 func getTerminalSize() (int, int) {
-	w, h, err := term.GetSize(os.Stdout.Fd())
-	if err != nil {
-		return 0, 0
+	w, h, ok := terminalSize()
+	if !ok {
+		sizeMu.Lock()
+		defer sizeMu.Unlock()
+		return fallbackWidth, 0
 	}
 
 	return w, h
 }
+
+// terminalSize function returns the width and height of the terminal like
+// getTerminalSize, without the fallback width: it returns false if the size
+// cannot be determined and is not overridden.
+func terminalSize() (int, int, bool) {
+	sizeMu.Lock()
+	defer sizeMu.Unlock()
+	if sizeCached {
+		return sizeWidth, sizeHeight, true
+	}
+
+	w, h, ok := detectTerminalSize()
+	if !ok {
+		return 0, 0, false
+	}
+
+	sizeCached, sizeWidth, sizeHeight = true, w, h
+	return w, h, true
+}
+
+// detectTerminalSize function returns the width and height of the terminal,
+// bypassing the cache. It returns false if the size cannot be determined.
+func detectTerminalSize() (int, int, bool) {
+	w, h, err := term.GetSize(os.Stdout.Fd())
+	if err != nil || w <= 0 {
+		return 0, 0, false
+	}
+
+	return w, h, true
+}
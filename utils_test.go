@@ -120,3 +120,150 @@ func TestTruncateString(t *testing.T) {
 		}
 	}
 }
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		input    string
+		width    int
+		options  []TruncateOption
+		expected string
+	}{
+		{
+			input:    "Hello, World!",
+			width:    10,
+			expected: "Hello, ...",
+		},
+		{
+			input:    "Hello, World!",
+			width:    10,
+			options:  []TruncateOption{TruncateAt(TruncateStart)},
+			expected: "... World!",
+		},
+		{
+			input:    "Hello, World!",
+			width:    9,
+			options:  []TruncateOption{TruncateAt(TruncateMiddle)},
+			expected: "Hel...ld!",
+		},
+		{
+			input:    "Hello, World!",
+			width:    10,
+			options:  []TruncateOption{TruncateWords()},
+			expected: "Hello,...",
+		},
+		{
+			input:    "Hello, World!",
+			width:    10,
+			options:  []TruncateOption{TruncateWords(), TruncateAt(TruncateStart)},
+			expected: "...World!",
+		},
+		{
+			input:    "Supercalifragilistic",
+			width:    8,
+			options:  []TruncateOption{TruncateWords()},
+			expected: "Super...",
+		},
+		{
+			input:    "héllo wörld",
+			width:    6,
+			options:  []TruncateOption{TruncateTail("…")},
+			expected: "héllo…",
+		},
+		{
+			input:    "日本語のテキスト",
+			width:    7,
+			expected: "日本...",
+		},
+		{
+			input:    "\x1b[1mbold text\x1b[0m",
+			width:    6,
+			expected: "\x1b[1mbol\x1b[0m...",
+		},
+	}
+
+	for _, test := range tests {
+		result := Truncate(test.input, test.width, test.options...)
+		if result != test.expected {
+			t.Errorf("Truncate(%q, %d) = %q; expected %q", test.input, test.width, result, test.expected)
+		}
+	}
+}
+
+func TestWrapString(t *testing.T) {
+	tests := []struct {
+		input    string
+		width    int
+		expected string
+	}{
+		{
+			input:    "the quick brown fox",
+			width:    10,
+			expected: "the quick\nbrown fox",
+		},
+		{
+			input:    "see /usr/local/share/doc",
+			width:    10,
+			expected: "see\n/usr/local\n/share/doc",
+		},
+		{
+			input:    "\x1b[1mbold words here\x1b[0m",
+			width:    10,
+			expected: "\x1b[1mbold words\x1b[m\n\x1b[1mhere\x1b[0m",
+		},
+		{
+			input:    "unchanged",
+			width:    0,
+			expected: "unchanged",
+		},
+	}
+
+	for _, test := range tests {
+		result := WrapString(test.input, test.width)
+		if result != test.expected {
+			t.Errorf("WrapString(%q, %d) = %q; expected %q", test.input, test.width, result, test.expected)
+		}
+	}
+}
+
+func TestHardWrap(t *testing.T) {
+	tests := []struct {
+		input    string
+		width    int
+		expected string
+	}{
+		{
+			input:    "the quick brown fox",
+			width:    10,
+			expected: "the quick \nbrown fox",
+		},
+		{
+			input:    "\x1b[31mabcdef\x1b[0m",
+			width:    3,
+			expected: "\x1b[31mabc\x1b[m\n\x1b[31mdef\x1b[0m",
+		},
+	}
+
+	for _, test := range tests {
+		result := HardWrap(test.input, test.width)
+		if result != test.expected {
+			t.Errorf("HardWrap(%q, %d) = %q; expected %q", test.input, test.width, result, test.expected)
+		}
+	}
+}
+
+func TestSetTerminalSize(t *testing.T) {
+	defer SetTerminalSize(0, 0)
+
+	SetTerminalSize(120, 40)
+	InvalidateTerminalSize()
+	if w, h := getTerminalSize(); w != 120 || h != 40 {
+		t.Errorf("getTerminalSize() = %d, %d; expected %d, %d", w, h, 120, 40)
+	}
+
+	SetTerminalSize(0, 0)
+	if _, _, ok := detectTerminalSize(); !ok {
+		if w, h := getTerminalSize(); w != fallbackWidth || h != 0 {
+			t.Errorf("getTerminalSize() = %d, %d; expected %d, %d", w, h, fallbackWidth, 0)
+		}
+	}
+}